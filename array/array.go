@@ -10,9 +10,15 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+
+	"github.com/with-go/standard/cycle"
+	"github.com/with-go/standard/pretty"
 )
 
 var (
+	InvalidSizeError     = errors.New("the given size or step should be greater than zero")
+	NonArrayElementError = errors.New("the element of the Array is a non-array type, " +
+		"it should be an Array or a []interface{}")
 	NonSliceTypeError = errors.New("the given parameter v is a non-slice type, " +
 		"parameter v should be a map")
 )
@@ -21,7 +27,7 @@ var (
 // pushed as the elements the new Array.
 func New(values ...interface{}) Array {
 	array := Array{}
-	array = array.Push(values...)
+	array.PushInPlace(values...)
 	return array
 }
 
@@ -36,7 +42,7 @@ func NewFromSlice(v interface{}) (Array, error) {
 	for index := 0; index < reflection.Len(); index++ {
 		newSlice[index] = reflection.Index(index).Interface()
 	}
-	return New().Push(newSlice...), nil
+	return newSlice, nil
 }
 
 // Array defines an Array Type. See "array" package documentation for more
@@ -44,15 +50,31 @@ func NewFromSlice(v interface{}) (Array, error) {
 type Array []interface{}
 
 // The Concat() function is used to merge two or more Arrays. This function
-// does not change the existing array, but instead returns a new Array.
+// does not change the existing array, but instead returns a new Array, which
+// never shares its backing array with the Array or the other Arrays.
 func (array Array) Concat(others ...Array) Array {
-	concatenatedArray := array
+	length := len(array)
+	for _, other := range others {
+		length += len(other)
+	}
+	concatenatedArray := make(Array, 0, length)
+	concatenatedArray = append(concatenatedArray, array...)
 	for _, other := range others {
-		concatenatedArray = concatenatedArray.Push(other...)
+		concatenatedArray = append(concatenatedArray, other...)
 	}
 	return concatenatedArray
 }
 
+// The ConcatInPlace() function is the same as Concat() function, but
+// appends the elements of the other Arrays to the Array itself. The Array
+// may keep using its backing array, so any other Array sharing it may see
+// the change.
+func (array *Array) ConcatInPlace(others ...Array) {
+	for _, other := range others {
+		*array = append(*array, other...)
+	}
+}
+
 // The DeepEqual() function is the same as Equal() function but, instead of using
 // an internal testing mechanism, it would use the DeepEqual() function from the
 // "reflect" package.
@@ -81,7 +103,7 @@ func (array Array) Filter(function FilterFunc) Array {
 	filteredArray := New()
 	for i, v := range array {
 		if function(array, i, v) {
-			filteredArray = filteredArray.Push(v)
+			filteredArray.PushInPlace(v)
 		}
 	}
 	return filteredArray
@@ -192,20 +214,35 @@ func (array Array) Length() int {
 func (array Array) Map(function MapFunc) Array {
 	newArray := New()
 	for i, v := range array {
-		newArray = newArray.Push(function(array, i, v))
+		newArray.PushInPlace(function(array, i, v))
 	}
 	return newArray
 }
 
 // The Pop() function removes the last element from the Array and returns the new
-// Array and that last element. This function does not change the existing array.
+// Array and that last element. This function does not change the existing array,
+// and the new Array never shares its backing array with the Array.
 func (array Array) Pop() (Array, interface{}) {
 	if len(array) == 0 {
-		return array, nil
+		return New(), nil
 	}
 	lastIndex := len(array) - 1
 	lastValue := array[lastIndex]
-	return array[:lastIndex], lastValue
+	return New(array[:lastIndex]...), lastValue
+}
+
+// The PopInPlace() function is the same as Pop() function, but removes the
+// last element from the Array itself, and returns that element. If the
+// Array is empty, it will returns nil. The element is left in the backing
+// array, so another Array sharing it is not changed.
+func (array *Array) PopInPlace() interface{} {
+	if len(*array) == 0 {
+		return nil
+	}
+	lastIndex := len(*array) - 1
+	lastValue := (*array)[lastIndex]
+	*array = (*array)[:lastIndex]
+	return lastValue
 }
 
 // The Present() function returns an Array Presenter, which capable to
@@ -215,9 +252,18 @@ func (array Array) Present() Presenter {
 }
 
 // The Push() function adds one or more elements to the end of an array and
-// returns the new Array. This function does not change the existing array.
+// returns the new Array. This function does not change the existing array,
+// and the new Array never shares its backing array with the Array.
 func (array Array) Push(values ...interface{}) Array {
-	return append(array, values...)
+	return array.Concat(values)
+}
+
+// The PushInPlace() function is the same as Push() function, but adds the
+// elements to the end of the Array itself, the same way as the built-in
+// append() function does. The Array may keep using its backing array, so
+// any other Array sharing it may see the change.
+func (array *Array) PushInPlace(values ...interface{}) {
+	*array = append(*array, values...)
 }
 
 // The Reflect() function returns the array element of the given index as a
@@ -245,20 +291,34 @@ func (array Array) Reflects() []reflect.Value {
 func (array Array) Reverse() Array {
 	reversedArray := New()
 	for i := len(array) - 1; i >= 0; i-- {
-		reversedArray = reversedArray.Push(array[i])
+		reversedArray.PushInPlace(array[i])
 	}
 	return reversedArray
 }
 
 // The Shift() function removes the first element from the Array and returns
 // the new Array and that removed element. This function does not change
-// the existing array.
+// the existing array, and the new Array never shares its backing array with
+// the Array.
 func (array Array) Shift() (Array, interface{}) {
 	if len(array) == 0 {
-		return array, nil
+		return New(), nil
 	}
 	firstValue := array[0]
-	return array[1:], firstValue
+	return New(array[1:]...), firstValue
+}
+
+// The ShiftInPlace() function is the same as Shift() function, but removes
+// the first element from the Array itself, and returns that element. If the
+// Array is empty, it will returns nil. The element is left in the backing
+// array, so another Array sharing it is not changed.
+func (array *Array) ShiftInPlace() interface{} {
+	if len(*array) == 0 {
+		return nil
+	}
+	firstValue := (*array)[0]
+	*array = (*array)[1:]
+	return firstValue
 }
 
 // The Sort() function sorts the elements of the Array in place based on a
@@ -290,17 +350,32 @@ func (array Array) Sort(function SortFunc) {
 	}
 }
 // The String() function returns a string representing the specified Array
-// and its elements.
+// and its elements. If the Array holds itself, the circular element is
+// printed as the Marker of "cycle" package.
 func (array Array) String() string {
-	b, _ := json.Marshal(array)
+	b, err := json.Marshal(array)
+	if err != nil && cycle.Check(array) != nil {
+		return pretty.Compact(array)
+	}
 	return string(b)
 }
 
 // The Unshift() function adds one or more elements to the beginning
 // of the Array and returns the new array. This function does not change
-// the existing array.
+// the existing array, and the new Array never shares its backing array
+// with the Array or the given values.
 func (array Array) Unshift(values ...interface{}) Array {
-	return append(values, array...)
+	return Array(values).Concat(array)
+}
+
+// The UnshiftInPlace() function is the same as Unshift() function, but adds
+// the elements to the beginning of the Array itself. The Array may keep
+// using its backing array, so any other Array sharing it may see the change.
+func (array *Array) UnshiftInPlace(values ...interface{}) {
+	length := len(*array)
+	*array = append(*array, values...)
+	copy((*array)[len(values):], (*array)[:length])
+	copy(*array, values)
 }
 
 // The Values() function returns a new slice that contains the values for
@@ -554,6 +554,16 @@ func TestArray_String(t *testing.T) {
 	}
 }
 
+func TestArray_String_circular(t *testing.T) {
+	array := New(1, nil)
+	array[1] = map[string]interface{}{"array": array}
+	if array.String() != "[1,{\"array\":[Circular]}]" {
+		t.Error("array.String() does not return the expected circular string")
+		t.Errorf("Expecting %s, got %s", "[1,{\"array\":[Circular]}]", array.String())
+		return
+	}
+}
+
 func TestArray_Unshift(t *testing.T) {
 	unshiftArray := New(4, 5, 1, 2, 3)
 	array := New(1, 2, 3)
@@ -579,4 +589,115 @@ func TestArray_Values(t *testing.T) {
 		t.Error("array.Values() does not have expected values")
 		t.Errorf("Expecting %v, got %v", values, valueSlice)
 	}
-}
\ No newline at end of file
+}
+
+func TestArray_ConcatInPlace(t *testing.T) {
+	array := New("a")
+	array.ConcatInPlace(New("b"), New("c", "d"))
+	if !array.Equal(New("a", "b", "c", "d")) {
+		t.Error("array.ConcatInPlace() values do not match")
+		t.Errorf("Expecting %v, got %v", New("a", "b", "c", "d"), array)
+		return
+	}
+	spare := make(Array, 1, 10)
+	concatenated := spare.Concat(New("a"))
+	concatenated[0] = "changed"
+	if spare[0] != nil || spare[:2][1] != nil {
+		t.Error("array.Concat() result shares its backing array with the Array")
+		t.Errorf("Expecting %v, got %v", nil, spare[0])
+		return
+	}
+}
+
+func TestArray_PopInPlace(t *testing.T) {
+	array := New("a", "b")
+	if lastValue := array.PopInPlace(); lastValue != "b" || !array.Equal(New("a")) {
+		t.Error("array.PopInPlace() value does not match")
+		t.Errorf("Expecting %v, got %v", "b", lastValue)
+		return
+	}
+	array.PopInPlace()
+	if lastValue := array.PopInPlace(); lastValue != nil || len(array) != 0 {
+		t.Error("array.PopInPlace() does not return nil for an empty Array")
+		t.Errorf("Expecting %v, got %v", nil, lastValue)
+		return
+	}
+	original := New(1, 2, 3)
+	popped, _ := original.Pop()
+	popped.Push(9)
+	popped[0] = 9
+	if !original.Equal(New(1, 2, 3)) {
+		t.Error("array.Pop() result shares its backing array with the Array")
+		t.Errorf("Expecting %v, got %v", New(1, 2, 3), original)
+		return
+	}
+	shared := New(1, 2)
+	view := shared[:]
+	view.PopInPlace()
+	if !shared.Equal(New(1, 2)) {
+		t.Error("array.PopInPlace() changes an Array sharing its backing array")
+		t.Errorf("Expecting %v, got %v", New(1, 2), shared)
+		return
+	}
+}
+
+func TestArray_PushInPlace(t *testing.T) {
+	array := make(Array, 0, 10)
+	array.PushInPlace("a", "b")
+	if !array.Equal(New("a", "b")) || cap(array) != 10 {
+		t.Error("array.PushInPlace() does not append to the Array itself")
+		t.Errorf("Expecting %v, got %v", New("a", "b"), array)
+		return
+	}
+	spare := make(Array, 2, 10)
+	first := spare.Push("first")
+	second := spare.Push("second")
+	if first[2] != "first" || second[2] != "second" {
+		t.Error("array.Push() result shares its backing array with the Array")
+		t.Errorf("Expecting %v, got %v", "first", first[2])
+		return
+	}
+}
+
+func TestArray_ShiftInPlace(t *testing.T) {
+	array := New("a", "b")
+	if firstValue := array.ShiftInPlace(); firstValue != "a" || !array.Equal(New("b")) {
+		t.Error("array.ShiftInPlace() value does not match")
+		t.Errorf("Expecting %v, got %v", "a", firstValue)
+		return
+	}
+	original := New(1, 2, 3)
+	shifted, _ := original.Shift()
+	shifted[0] = 9
+	if !original.Equal(New(1, 2, 3)) {
+		t.Error("array.Shift() result shares its backing array with the Array")
+		t.Errorf("Expecting %v, got %v", New(1, 2, 3), original)
+		return
+	}
+	shared := New(1, 2)
+	view := shared[:]
+	view.ShiftInPlace()
+	if !shared.Equal(New(1, 2)) {
+		t.Error("array.ShiftInPlace() changes an Array sharing its backing array")
+		t.Errorf("Expecting %v, got %v", New(1, 2), shared)
+		return
+	}
+}
+
+func TestArray_UnshiftInPlace(t *testing.T) {
+	array := New(3, 4, 5)
+	array.UnshiftInPlace(1, 2)
+	if !array.Equal(New(1, 2, 3, 4, 5)) {
+		t.Error("array.UnshiftInPlace() values do not match")
+		t.Errorf("Expecting %v, got %v", New(1, 2, 3, 4, 5), array)
+		return
+	}
+	values := make([]interface{}, 1, 10)
+	unshifted := New(1).Unshift(values...)
+	unshifted[0] = "changed"
+	if values[0] != nil || values[:2][1] != nil {
+		t.Error("array.Unshift() result shares its backing array with the values")
+		t.Errorf("Expecting %v, got %v", nil, values[0])
+		return
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package array
+
+// The Chunk() function splits the Array into a new Array of Arrays, each
+// holding the given size of elements. The last chunk holds the remaining
+// elements, so it may be shorter than the given size. Use ChunkPad()
+// function to keep every chunk the same size. If the given size is less
+// than 1, it will returns InvalidSizeError.
+func (array Array) Chunk(size int) (Array, error) {
+	if err := checkSize(size, size); err != nil {
+		return nil, err
+	}
+	chunks := Array{}
+	for start := 0; start < len(array); start += size {
+		end := start + size
+		if end > len(array) {
+			end = len(array)
+		}
+		chunks.PushInPlace(array[start:end].Concat())
+	}
+	return chunks, nil
+}
+
+// The ChunkPad() function is the same as Chunk() function, but fills the
+// last chunk with the given value up to the given size.
+func (array Array) ChunkPad(size int, value interface{}) (Array, error) {
+	return array.SlidingWindowPad(size, size, value)
+}
+
+// The SlidingWindow() function returns a new Array of Arrays, each holding
+// the given size of consecutive elements, starting at every given step of
+// elements. A window that would go past the end of the Array is dropped.
+// Use SlidingWindowPad() function to keep such windows. If the given size
+// or step is less than 1, it will returns InvalidSizeError.
+func (array Array) SlidingWindow(size int, step int) (Array, error) {
+	if err := checkSize(size, step); err != nil {
+		return nil, err
+	}
+	windows := Array{}
+	for start := 0; start+size <= len(array); start += step {
+		windows.PushInPlace(array[start : start+size].Concat())
+	}
+	return windows, nil
+}
+
+// The SlidingWindowPad() function is the same as SlidingWindow() function,
+// but keeps every window that starts inside the Array, filling the windows
+// that go past the end of the Array with the given value.
+func (array Array) SlidingWindowPad(size int, step int, value interface{}) (Array, error) {
+	if err := checkSize(size, step); err != nil {
+		return nil, err
+	}
+	windows := Array{}
+	for start := 0; start < len(array); start += step {
+		end := start + size
+		if end > len(array) {
+			end = len(array)
+		}
+		window := array[start:end].Concat()
+		for len(window) < size {
+			window.PushInPlace(value)
+		}
+		windows.PushInPlace(window)
+	}
+	return windows, nil
+}
+
+// The Transpose() function treats the Array as rows of a matrix, where each
+// element is an Array or a []interface{}, and returns a new Array of Arrays
+// holding its columns. If the rows have different lengths, the columns are
+// truncated to the length of the shortest row. Use TransposePad() function
+// to pad the shorter rows instead. If an element is not an Array, it will
+// returns NonArrayElementError.
+func (array Array) Transpose() (Array, error) {
+	rows, err := array.rows()
+	if err != nil {
+		return nil, err
+	}
+	width := 0
+	for index, row := range rows {
+		if index == 0 || len(row) < width {
+			width = len(row)
+		}
+	}
+	return transpose(rows, width, nil), nil
+}
+
+// The TransposePad() function is the same as Transpose() function, but
+// fills the missing elements of the shorter rows with the given value, so
+// there are as many columns as the length of the longest row.
+func (array Array) TransposePad(value interface{}) (Array, error) {
+	rows, err := array.rows()
+	if err != nil {
+		return nil, err
+	}
+	width := 0
+	for _, row := range rows {
+		if len(row) > width {
+			width = len(row)
+		}
+	}
+	return transpose(rows, width, value), nil
+}
+
+// The Unzip() function is the reverse of Zip() function. It takes an Array
+// of tuples and returns a new Array of Arrays, where the first Array holds
+// the first element of each tuple, and so on. Tuples of different lengths
+// are handled the same way as Transpose() function does.
+func (array Array) Unzip() (Array, error) {
+	return array.Transpose()
+}
+
+// The UnzipPad() function is the same as Unzip() function, but handles
+// tuples of different lengths the same way as TransposePad() function
+// does.
+func (array Array) UnzipPad(value interface{}) (Array, error) {
+	return array.TransposePad(value)
+}
+
+// The Zip() function returns a new Array of Arrays, where each Array is a
+// tuple that holds the elements of the same index in the Array and the
+// other Arrays. The result is as long as the shortest Array. Use ZipPad()
+// function to make it as long as the longest Array instead.
+func (array Array) Zip(others ...Array) Array {
+	tuples, _ := Array{array}.Concat(arraysOf(others)).Transpose()
+	return tuples
+}
+
+// The ZipPad() function is the same as Zip() function, but fills the
+// tuples past the end of the shorter Arrays with the given value.
+func (array Array) ZipPad(value interface{}, others ...Array) Array {
+	tuples, _ := Array{array}.Concat(arraysOf(others)).TransposePad(value)
+	return tuples
+}
+
+// The rows() function returns the elements of the Array as Arrays. If an
+// element is not an Array, it will returns NonArrayElementError.
+func (array Array) rows() ([]Array, error) {
+	rows := make([]Array, len(array))
+	for index, element := range array {
+		switch row := element.(type) {
+		case Array:
+			rows[index] = row
+		case []interface{}:
+			rows[index] = row
+		default:
+			return nil, NonArrayElementError
+		}
+	}
+	return rows, nil
+}
+
+func arraysOf(arrays []Array) Array {
+	elements := make(Array, len(arrays))
+	for index, array := range arrays {
+		elements[index] = array
+	}
+	return elements
+}
+
+func transpose(rows []Array, width int, value interface{}) Array {
+	columns := make(Array, width)
+	for column := range columns {
+		values := make(Array, len(rows))
+		for index, row := range rows {
+			if column < len(row) {
+				values[index] = row[column]
+			} else {
+				values[index] = value
+			}
+		}
+		columns[column] = values
+	}
+	return columns
+}
+
+func checkSize(size int, step int) error {
+	if size < 1 || step < 1 {
+		return InvalidSizeError
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package array
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestArray_Chunk(t *testing.T) {
+	chunks, err := New(1, 2, 3, 4, 5).Chunk(2)
+	if err != nil {
+		t.Error("array.Chunk() returns an error")
+		t.Errorf("Expecting %v, got %v", nil, err)
+		return
+	}
+	expected := New(New(1, 2), New(3, 4), New(5))
+	if !reflect.DeepEqual(chunks, expected) {
+		t.Error("array.Chunk() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, chunks)
+		return
+	}
+	if chunks, _ := New().Chunk(3); len(chunks) != 0 {
+		t.Error("array.Chunk() empty value does not match")
+		t.Errorf("Expecting %v, got %v", New(), chunks)
+		return
+	}
+}
+
+func TestArray_Chunk_invalidSize(t *testing.T) {
+	if _, err := New(1).Chunk(0); err != InvalidSizeError {
+		t.Error("array.Chunk() error does not match")
+		t.Errorf("Expecting %v, got %v", InvalidSizeError, err)
+		return
+	}
+	if _, err := New(1).ChunkPad(-1, nil); err != InvalidSizeError {
+		t.Error("array.ChunkPad() error does not match")
+		t.Errorf("Expecting %v, got %v", InvalidSizeError, err)
+		return
+	}
+	if _, err := New(1).SlidingWindow(1, 0); err != InvalidSizeError {
+		t.Error("array.SlidingWindow() error does not match")
+		t.Errorf("Expecting %v, got %v", InvalidSizeError, err)
+		return
+	}
+	if _, err := New(1).SlidingWindowPad(0, 1, nil); err != InvalidSizeError {
+		t.Error("array.SlidingWindowPad() error does not match")
+		t.Errorf("Expecting %v, got %v", InvalidSizeError, err)
+		return
+	}
+}
+
+func TestArray_ChunkPad(t *testing.T) {
+	chunks, _ := New(1, 2, 3, 4, 5).ChunkPad(3, 0)
+	expected := New(New(1, 2, 3), New(4, 5, 0))
+	if !reflect.DeepEqual(chunks, expected) {
+		t.Error("array.ChunkPad() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, chunks)
+		return
+	}
+}
+
+func TestArray_SlidingWindow(t *testing.T) {
+	original := New(1, 2, 3, 4, 5)
+	windows, _ := original.SlidingWindow(3, 1)
+	expected := New(New(1, 2, 3), New(2, 3, 4), New(3, 4, 5))
+	if !reflect.DeepEqual(windows, expected) {
+		t.Error("array.SlidingWindow() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, windows)
+		return
+	}
+	windows[0].(Array)[0] = 9
+	if original[0] != 1 {
+		t.Error("array.SlidingWindow() shares its backing array")
+		return
+	}
+	windows, _ = original.SlidingWindow(2, 2)
+	expected = New(New(1, 2), New(3, 4))
+	if !reflect.DeepEqual(windows, expected) {
+		t.Error("array.SlidingWindow() step value does not match")
+		t.Errorf("Expecting %v, got %v", expected, windows)
+		return
+	}
+}
+
+func TestArray_SlidingWindowPad(t *testing.T) {
+	windows, _ := New(1, 2, 3, 4, 5).SlidingWindowPad(3, 2, nil)
+	expected := New(New(1, 2, 3), New(3, 4, 5), New(5, nil, nil))
+	if !reflect.DeepEqual(windows, expected) {
+		t.Error("array.SlidingWindowPad() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, windows)
+		return
+	}
+}
+
+func TestArray_Transpose(t *testing.T) {
+	matrix := New(New(1, 2, 3), []interface{}{4, 5, 6}, New(7, 8))
+	transposed, err := matrix.Transpose()
+	if err != nil {
+		t.Error("array.Transpose() returns an error")
+		t.Errorf("Expecting %v, got %v", nil, err)
+		return
+	}
+	expected := New(New(1, 4, 7), New(2, 5, 8))
+	if !reflect.DeepEqual(transposed, expected) {
+		t.Error("array.Transpose() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, transposed)
+		return
+	}
+	if transposed, _ := New().Transpose(); len(transposed) != 0 {
+		t.Error("array.Transpose() empty value does not match")
+		t.Errorf("Expecting %v, got %v", New(), transposed)
+		return
+	}
+}
+
+func TestArray_Transpose_nonArrayElement(t *testing.T) {
+	if _, err := New(New(1), 2).Transpose(); err != NonArrayElementError {
+		t.Error("array.Transpose() error does not match")
+		t.Errorf("Expecting %v, got %v", NonArrayElementError, err)
+		return
+	}
+	if _, err := New(New(1), "a").TransposePad(nil); err != NonArrayElementError {
+		t.Error("array.TransposePad() error does not match")
+		t.Errorf("Expecting %v, got %v", NonArrayElementError, err)
+		return
+	}
+	if _, err := New(1).Unzip(); err != NonArrayElementError {
+		t.Error("array.Unzip() error does not match")
+		t.Errorf("Expecting %v, got %v", NonArrayElementError, err)
+		return
+	}
+}
+
+func TestArray_TransposePad(t *testing.T) {
+	transposed, _ := New(New(1, 2, 3), New(4)).TransposePad("-")
+	expected := New(New(1, 4), New(2, "-"), New(3, "-"))
+	if !reflect.DeepEqual(transposed, expected) {
+		t.Error("array.TransposePad() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, transposed)
+		return
+	}
+}
+
+func TestArray_Unzip(t *testing.T) {
+	series := New(1, 2, 3)
+	others := New("a", "b", "c")
+	unzipped, _ := series.Zip(others).Unzip()
+	expected := New(series, others)
+	if !reflect.DeepEqual(unzipped, expected) {
+		t.Error("array.Unzip() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, unzipped)
+		return
+	}
+}
+
+func TestArray_UnzipPad(t *testing.T) {
+	unzipped, _ := New(New(1, "a"), New(2)).UnzipPad("")
+	expected := New(New(1, 2), New("a", ""))
+	if !reflect.DeepEqual(unzipped, expected) {
+		t.Error("array.UnzipPad() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, unzipped)
+		return
+	}
+}
+
+func TestArray_Zip(t *testing.T) {
+	zipped := New(1, 2, 3).Zip(New("a", "b"), New(true, false, true))
+	expected := New(New(1, "a", true), New(2, "b", false))
+	if !reflect.DeepEqual(zipped, expected) {
+		t.Error("array.Zip() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, zipped)
+		return
+	}
+}
+
+func TestArray_ZipPad(t *testing.T) {
+	zipped := New(1, 2, 3).ZipPad(nil, New("a"))
+	expected := New(New(1, "a"), New(2, nil), New(3, nil))
+	if !reflect.DeepEqual(zipped, expected) {
+		t.Error("array.ZipPad() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, zipped)
+		return
+	}
+}
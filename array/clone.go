@@ -0,0 +1,31 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package array
+
+import (
+	"github.com/with-go/standard/clone"
+)
+
+// The Clone() function returns a shallow copy of the Array, which holds the
+// same elements as the Array in a new backing array. A nested Array, Object
+// or Collection is shared with the Array. Use DeepClone() function to copy
+// them as well.
+func (array Array) Clone() Array {
+	return array.Concat()
+}
+
+// The DeepClone() function returns a deep copy of the Array, which also
+// copies the nested Arrays, Objects, Collections, native slices and native
+// maps, the same way as the Deep() function of "clone" package does. An
+// element that appears more than once in the Array, including an element
+// that holds the Array itself, is copied only once, so the copy keeps the
+// same shared and circular references.
+func (array Array) DeepClone() Array {
+	if array == nil {
+		return New()
+	}
+	return clone.Deep(array).(Array)
+}
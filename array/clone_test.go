@@ -0,0 +1,61 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package array
+
+import (
+	"testing"
+)
+
+func TestArray_Clone(t *testing.T) {
+	nested := New(1)
+	array := New("a", nested)
+	cloned := array.Clone()
+	cloned[0] = "b"
+	if array[0] != "a" {
+		t.Error("array.Clone() value is shared with the original")
+		t.Errorf("Expecting %v, got %v", "a", array[0])
+		return
+	}
+	cloned[1].(Array)[0] = 2
+	if nested[0] != 2 {
+		t.Error("array.Clone() nested value does not match")
+		t.Errorf("Expecting %v, got %v", 2, nested[0])
+		return
+	}
+}
+
+func TestArray_DeepClone(t *testing.T) {
+	nested := New(1)
+	array := New("a", nested, nested, map[string]interface{}{"b": []interface{}{2}})
+	array = append(array, nil)
+	array[4] = array
+	cloned := array.DeepClone()
+	if cloned.String() != array.String() {
+		t.Error("array.DeepClone() value does not match")
+		t.Errorf("Expecting %v, got %v", array.String(), cloned.String())
+		return
+	}
+	cloned[1].(Array)[0] = 3
+	cloned[3].(map[string]interface{})["b"].([]interface{})[0] = 4
+	if nested[0] != 1 || array[3].(map[string]interface{})["b"].([]interface{})[0] != 2 {
+		t.Error("array.DeepClone() value is shared with the original")
+		t.Errorf("Got %v", array.String())
+		return
+	}
+	if cloned[2].(Array)[0] != 3 {
+		t.Error("array.DeepClone() shared reference does not match")
+		t.Errorf("Expecting %v, got %v", 3, cloned[2].(Array)[0])
+		return
+	}
+	if &cloned[4].(Array)[0] != &cloned[0] {
+		t.Error("array.DeepClone() circular reference does not match")
+		return
+	}
+	if New().DeepClone() == nil || Array(nil).DeepClone() == nil {
+		t.Error("array.DeepClone() empty value does not match")
+		return
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package array
+
+import (
+	"fmt"
+
+	"github.com/with-go/standard/pretty"
+)
+
+// The Format() function implements fmt.Formatter. The %v verb prints the
+// same compact string as String() function, the %+v verb prints indented
+// multi-line output using the "pretty" package, and the %#v verb prints the
+// Go source that rebuilds the Array.
+func (array Array) Format(state fmt.State, verb rune) {
+	pretty.Format(state, verb, array, []interface{}(array))
+}
+
+// The GoString() function returns the Go source that rebuilds the Array,
+// such as array.Array{1, "a"}.
+func (array Array) GoString() string {
+	if array == nil {
+		return "array.Array{}"
+	}
+	return pretty.GoString(array)
+}
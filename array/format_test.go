@@ -0,0 +1,42 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package array
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestArray_Format(t *testing.T) {
+	array := New(1, "a", New(true))
+	tests := []struct {
+		format   string
+		expected string
+	}{
+		{"%v", "[1,\"a\",[true]]"},
+		{"%s", "[1,\"a\",[true]]"},
+		{"%+v", "[\n  1,\n  \"a\",\n  [\n    true\n  ]\n]"},
+		{"%#v", "array.Array{1, \"a\", array.Array{true}}"},
+		{"%d", "[1 %!d(string=a) [%!d(bool=true)]]"},
+	}
+	for _, test := range tests {
+		if result := fmt.Sprintf(test.format, array); result != test.expected {
+			t.Error("array.Format() value does not match")
+			t.Errorf("Expecting %q, got %q", test.expected, result)
+			return
+		}
+	}
+}
+
+func TestArray_GoString(t *testing.T) {
+	array := New(int64(1), 2.0, nil, []string{"a"})
+	expected := "array.Array{int64(1), 2.0, nil, []string{\"a\"}}"
+	if array.GoString() != expected {
+		t.Error("array.GoString() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, array.GoString())
+		return
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package array
+
+import (
+	"bytes"
+	"encoding/gob"
+	"reflect"
+	"testing"
+)
+
+func TestArray_GobEncode(t *testing.T) {
+	array := New(1, int8(2), uint(3), 4.5, "a", true, nil, []byte("b"), New("c", New()),
+		map[string]interface{}{"d": 1})
+	buffer := &bytes.Buffer{}
+	if err := gob.NewEncoder(buffer).Encode(array); err != nil {
+		t.Error("gob.Encoder.Encode(array) failed to encode")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	var decoded Array
+	if err := gob.NewDecoder(buffer).Decode(&decoded); err != nil {
+		t.Error("gob.Decoder.Decode(array) failed to decode")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	if !reflect.DeepEqual(decoded, array) {
+		t.Error("array.GobEncode() does not round-trip")
+		t.Errorf("Expecting %#v, got %#v", array, decoded)
+		return
+	}
+}
+
+func TestArray_MarshalBinary(t *testing.T) {
+	array := New()
+	data, err := array.MarshalBinary()
+	if err != nil {
+		t.Error("array.MarshalBinary() failed to encode")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	decoded := New("stale")
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Error("array.UnmarshalBinary(data) failed to decode")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	if decoded == nil || decoded.Length() != 0 {
+		t.Error("array.UnmarshalBinary(data) does not return an empty Array")
+		t.Errorf("Expecting %#v, got %#v", array, decoded)
+		return
+	}
+}
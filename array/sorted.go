@@ -0,0 +1,80 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package array
+
+import "sort"
+
+// The BinarySearch() function searches a value in the Array, which has to
+// be sorted using the same compare function, the same way as Sort()
+// function does. It returns the index of the first element that compares
+// equal to the value and true, or the index where the value would be
+// inserted and false if there is no such element.
+func (array Array) BinarySearch(value interface{}, function SortFunc) (int, bool) {
+	index := array.LowerBound(value, function)
+	return index, index < len(array) && function(array[index], value) == 0
+}
+
+// The InsertSorted() function returns a new Array with the value inserted
+// into the Array, which has to be sorted using the same compare function,
+// so the new Array stays sorted. The value is inserted after the elements
+// that compare equal to it. This function does not change the existing
+// array, and the new Array never shares its backing array with the Array.
+func (array Array) InsertSorted(value interface{}, function SortFunc) Array {
+	index := array.UpperBound(value, function)
+	inserted := make(Array, 0, len(array)+1)
+	inserted = append(inserted, array[:index]...)
+	inserted = append(inserted, value)
+	return append(inserted, array[index:]...)
+}
+
+// The InsertSortedInPlace() function is the same as InsertSorted()
+// function, but inserts the value into the Array itself.
+func (array *Array) InsertSortedInPlace(value interface{}, function SortFunc) {
+	index := array.UpperBound(value, function)
+	*array = append(*array, nil)
+	copy((*array)[index+1:], (*array)[index:])
+	(*array)[index] = value
+}
+
+// The LowerBound() function returns the index of the first element of the
+// Array, which has to be sorted using the same compare function, that is
+// not sorted before the value. If there is no such element, it returns the
+// length of the Array.
+func (array Array) LowerBound(value interface{}, function SortFunc) int {
+	return sort.Search(len(array), func(index int) bool {
+		return function(array[index], value) >= 0
+	})
+}
+
+// The MergeSorted() function merges the Array and the other Array, which
+// both have to be sorted using the same compare function, into a new sorted
+// Array. Elements that compare equal keep their order, and the elements of
+// the Array come before those of the other Array.
+func (array Array) MergeSorted(other Array, function SortFunc) Array {
+	merged := make(Array, 0, len(array)+len(other))
+	i, j := 0, 0
+	for i < len(array) && j < len(other) {
+		if function(other[j], array[i]) < 0 {
+			merged = append(merged, other[j])
+			j++
+		} else {
+			merged = append(merged, array[i])
+			i++
+		}
+	}
+	merged = append(merged, array[i:]...)
+	return append(merged, other[j:]...)
+}
+
+// The UpperBound() function returns the index of the first element of the
+// Array, which has to be sorted using the same compare function, that is
+// sorted after the value. If there is no such element, it returns the
+// length of the Array.
+func (array Array) UpperBound(value interface{}, function SortFunc) int {
+	return sort.Search(len(array), func(index int) bool {
+		return function(array[index], value) > 0
+	})
+}
@@ -0,0 +1,112 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package array
+
+import (
+	"reflect"
+	"testing"
+)
+
+func compareInt(a interface{}, b interface{}) int {
+	return a.(int) - b.(int)
+}
+
+func TestArray_BinarySearch(t *testing.T) {
+	array := New(1, 3, 3, 5, 7)
+	tests := []struct {
+		value   int
+		index   int
+		isFound bool
+	}{
+		{0, 0, false},
+		{1, 0, true},
+		{3, 1, true},
+		{4, 3, false},
+		{7, 4, true},
+		{8, 5, false},
+	}
+	for _, test := range tests {
+		index, isFound := array.BinarySearch(test.value, compareInt)
+		if index != test.index || isFound != test.isFound {
+			t.Error("array.BinarySearch() value does not match")
+			t.Errorf("Expecting %v %v, got %v %v", test.index, test.isFound, index, isFound)
+			return
+		}
+	}
+	if index, isFound := New().BinarySearch(1, compareInt); index != 0 || isFound {
+		t.Error("array.BinarySearch() empty value does not match")
+		return
+	}
+}
+
+func TestArray_InsertSorted(t *testing.T) {
+	original := New(1, 3, 5)
+	inserted := original.InsertSorted(4, compareInt).InsertSorted(0, compareInt).InsertSorted(6, compareInt)
+	expected := New(0, 1, 3, 4, 5, 6)
+	if !inserted.Equal(expected) || !original.Equal(New(1, 3, 5)) {
+		t.Error("array.InsertSorted() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, inserted)
+		return
+	}
+}
+
+func TestArray_InsertSortedInPlace(t *testing.T) {
+	type entry struct {
+		key   int
+		value string
+	}
+	compare := func(a interface{}, b interface{}) int {
+		return a.(entry).key - b.(entry).key
+	}
+	array := New()
+	array.InsertSortedInPlace(entry{2, "a"}, compare)
+	array.InsertSortedInPlace(entry{1, "b"}, compare)
+	array.InsertSortedInPlace(entry{2, "c"}, compare)
+	expected := New(entry{1, "b"}, entry{2, "a"}, entry{2, "c"})
+	if !reflect.DeepEqual(array, expected) {
+		t.Error("array.InsertSortedInPlace() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, array)
+		return
+	}
+}
+
+func TestArray_LowerBound(t *testing.T) {
+	array := New(1, 3, 3, 5)
+	if index := array.LowerBound(3, compareInt); index != 1 {
+		t.Error("array.LowerBound() value does not match")
+		t.Errorf("Expecting %v, got %v", 1, index)
+		return
+	}
+	if index := array.LowerBound(6, compareInt); index != 4 {
+		t.Error("array.LowerBound() past the end does not match")
+		t.Errorf("Expecting %v, got %v", 4, index)
+		return
+	}
+}
+
+func TestArray_MergeSorted(t *testing.T) {
+	merged := New(1, 4, 4, 9).MergeSorted(New(0, 4, 5, 10, 11), compareInt)
+	expected := New(0, 1, 4, 4, 4, 5, 9, 10, 11)
+	if !merged.Equal(expected) {
+		t.Error("array.MergeSorted() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, merged)
+		return
+	}
+}
+
+func TestArray_UpperBound(t *testing.T) {
+	array := New(1, 3, 3, 5)
+	if index := array.UpperBound(3, compareInt); index != 3 {
+		t.Error("array.UpperBound() value does not match")
+		t.Errorf("Expecting %v, got %v", 3, index)
+		return
+	}
+	if index := array.UpperBound(0, compareInt); index != 0 {
+		t.Error("array.UpperBound() before the start does not match")
+		t.Errorf("Expecting %v, got %v", 0, index)
+		return
+	}
+}
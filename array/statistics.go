@@ -0,0 +1,316 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package array
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"sort"
+)
+
+var (
+	EmptyArrayError           = errors.New("array does not contain any element")
+	Int64OverflowError        = errors.New("the result overflows the int64 type")
+	InvalidInterpolationError = errors.New("the given interpolation is not supported")
+	InvalidPercentileError    = errors.New("the given percentile should be between 0 and 100")
+	NonFiniteElementError     = errors.New("array contains element that is NaN or infinite")
+	NotEnoughElementsError    = errors.New("array does not contain enough elements")
+)
+
+// Interpolation defines how the Percentile() function computes a percentile
+// that lies between two elements.
+type Interpolation int
+
+const (
+	// LinearInterpolation interpolates linearly between the two elements.
+	LinearInterpolation Interpolation = iota
+	// LowerInterpolation takes the lower element.
+	LowerInterpolation
+	// HigherInterpolation takes the higher element.
+	HigherInterpolation
+	// NearestInterpolation takes the nearest element, or the lower element if
+	// the percentile lies exactly halfway between the two elements.
+	NearestInterpolation
+	// MidpointInterpolation takes the average of the two elements.
+	MidpointInterpolation
+)
+
+// Bin defines a bin of a histogram, counting the elements that are greater
+// than or equal to the Lower bound and less than the Upper bound. The last
+// bin of a histogram also counts the elements equal to its Upper bound.
+type Bin struct {
+	Lower float64
+	Upper float64
+	Count int
+}
+
+// The Histogram() function divides the range between the smallest and the
+// largest element into the given number of bins of equal width, and counts
+// the elements of each bin. If every element has the same value, they are
+// all counted in the first bin. If the given number of bins is less than 1,
+// it will returns InvalidSizeError. If an element is NaN or infinite, it
+// will returns NonFiniteElementError.
+func (presenter Presenter) Histogram(bins int) ([]Bin, error) {
+	if bins < 1 {
+		return nil, InvalidSizeError
+	}
+	slice, err := presenter.numbers()
+	if err != nil {
+		return nil, err
+	}
+	for _, value := range slice {
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			return nil, NonFiniteElementError
+		}
+	}
+	min, _ := presenter.Min()
+	max, _ := presenter.Max()
+	width := (max - min) / float64(bins)
+	if math.IsInf(width, 0) {
+		// The range overflows a float64, so it is divided before subtracting.
+		width = max/float64(bins) - min/float64(bins)
+	}
+	histogram := make([]Bin, bins)
+	for index := range histogram {
+		histogram[index].Lower = min + float64(index)*width
+		histogram[index].Upper = min + float64(index+1)*width
+	}
+	histogram[bins-1].Upper = max
+	for _, value := range slice {
+		position := 0.0
+		if width > 0 {
+			position = (value - min) / width
+		}
+		index := bins - 1
+		if position < 0 {
+			index = 0
+		} else if position < float64(bins) {
+			index = int(position)
+		}
+		histogram[index].Count++
+	}
+	return histogram, nil
+}
+
+// The Max() function returns the largest element of the Array as a float64.
+// If the Array is empty, it will returns EmptyArrayError.
+func (presenter Presenter) Max() (float64, error) {
+	slice, err := presenter.numbers()
+	if err != nil {
+		return 0, err
+	}
+	max := slice[0]
+	for _, value := range slice[1:] {
+		if value > max {
+			max = value
+		}
+	}
+	return max, nil
+}
+
+// The Mean() function returns the arithmetic mean of the elements of the
+// Array. If the Array is empty, it will returns EmptyArrayError.
+func (presenter Presenter) Mean() (float64, error) {
+	slice, err := presenter.numbers()
+	if err != nil {
+		return 0, err
+	}
+	return sum(slice) / float64(len(slice)), nil
+}
+
+// The Median() function returns the median of the elements of the Array,
+// which is the 50th percentile using LinearInterpolation.
+func (presenter Presenter) Median() (float64, error) {
+	return presenter.Percentile(50, LinearInterpolation)
+}
+
+// The Min() function returns the smallest element of the Array as a float64.
+// If the Array is empty, it will returns EmptyArrayError.
+func (presenter Presenter) Min() (float64, error) {
+	slice, err := presenter.numbers()
+	if err != nil {
+		return 0, err
+	}
+	min := slice[0]
+	for _, value := range slice[1:] {
+		if value < min {
+			min = value
+		}
+	}
+	return min, nil
+}
+
+// The Mode() function returns the most frequent elements of the Array,
+// sorted in ascending order. If the Array is empty, it will returns
+// EmptyArrayError.
+func (presenter Presenter) Mode() ([]float64, error) {
+	slice, err := presenter.numbers()
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[float64]int)
+	highest := 0
+	for _, value := range slice {
+		counts[value]++
+		if counts[value] > highest {
+			highest = counts[value]
+		}
+	}
+	var modes []float64
+	for value, count := range counts {
+		if count == highest {
+			modes = append(modes, value)
+		}
+	}
+	sort.Float64s(modes)
+	return modes, nil
+}
+
+// The Percentile() function returns the p-th percentile of the elements of
+// the Array, where p is between 0 and 100. A percentile that lies between two
+// elements is computed using the given Interpolation. If the Array is
+// empty, it will returns EmptyArrayError.
+func (presenter Presenter) Percentile(p float64, interpolation Interpolation) (float64, error) {
+	if !(p >= 0 && p <= 100) {
+		return 0, InvalidPercentileError
+	}
+	slice, err := presenter.numbers()
+	if err != nil {
+		return 0, err
+	}
+	sorted := append([]float64{}, slice...)
+	sort.Float64s(sorted)
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := sorted[int(math.Floor(rank))]
+	higher := sorted[int(math.Ceil(rank))]
+	fraction := rank - math.Floor(rank)
+	switch interpolation {
+	case LinearInterpolation:
+		return lower + (higher-lower)*fraction, nil
+	case LowerInterpolation:
+		return lower, nil
+	case HigherInterpolation:
+		return higher, nil
+	case NearestInterpolation:
+		if fraction > 0.5 {
+			return higher, nil
+		}
+		return lower, nil
+	case MidpointInterpolation:
+		return (lower + higher) / 2, nil
+	}
+	return 0, InvalidInterpolationError
+}
+
+// The SampleStdDev() function returns the sample standard deviation of the
+// elements of the Array, which is the square root of SampleVariance().
+func (presenter Presenter) SampleStdDev() (float64, error) {
+	variance, err := presenter.SampleVariance()
+	return math.Sqrt(variance), err
+}
+
+// The SampleVariance() function returns the sample variance of the
+// elements of the Array, dividing by the number of elements minus one. If
+// the Array has less than two elements, it will returns
+// NotEnoughElementsError.
+func (presenter Presenter) SampleVariance() (float64, error) {
+	slice, err := presenter.numbers()
+	if err != nil {
+		return 0, err
+	}
+	if len(slice) < 2 {
+		return 0, NotEnoughElementsError
+	}
+	return squaredDeviations(slice) / float64(len(slice)-1), nil
+}
+
+// The StdDev() function returns the population standard deviation of the
+// elements of the Array, which is the square root of Variance().
+func (presenter Presenter) StdDev() (float64, error) {
+	variance, err := presenter.Variance()
+	return math.Sqrt(variance), err
+}
+
+// The Sum() function returns the sum of the elements of the Array as a
+// float64. An empty Array sums to 0. Use SumInt64() function to sum integers
+// exactly.
+func (presenter Presenter) Sum() (float64, error) {
+	slice, err := presenter.AsFloat64Slice()
+	if err != nil {
+		return 0, err
+	}
+	return sum(slice), nil
+}
+
+// The SumInt64() function returns the exact sum of the integer elements of
+// the Array. If an element is not an integer, it will returns
+// ElementTypeNotInt64Error, and if the sum, or an unsigned element, does
+// not fit in an int64, it will returns Int64OverflowError.
+func (presenter Presenter) SumInt64() (int64, error) {
+	var total int64
+	for _, reflection := range presenter.array.Reflects() {
+		var value int64
+		switch reflection.Kind() {
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			if reflection.Uint() > math.MaxInt64 {
+				return 0, Int64OverflowError
+			}
+			value = int64(reflection.Uint())
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			value = reflection.Int()
+		default:
+			return 0, ElementTypeNotInt64Error
+		}
+		result := total + value
+		if (value > 0 && result < total) || (value < 0 && result > total) {
+			return 0, Int64OverflowError
+		}
+		total = result
+	}
+	return total, nil
+}
+
+// The Variance() function returns the population variance of the elements
+// of the Array, dividing by the number of elements. If the Array is empty,
+// it will returns EmptyArrayError.
+func (presenter Presenter) Variance() (float64, error) {
+	slice, err := presenter.numbers()
+	if err != nil {
+		return 0, err
+	}
+	return squaredDeviations(slice) / float64(len(slice)), nil
+}
+
+// The numbers() function returns the Array as a float64 slice, or
+// EmptyArrayError if the Array is empty.
+func (presenter Presenter) numbers() ([]float64, error) {
+	slice, err := presenter.AsFloat64Slice()
+	if err != nil {
+		return nil, err
+	}
+	if len(slice) == 0 {
+		return nil, EmptyArrayError
+	}
+	return slice, nil
+}
+
+func sum(slice []float64) float64 {
+	total := 0.0
+	for _, value := range slice {
+		total += value
+	}
+	return total
+}
+
+func squaredDeviations(slice []float64) float64 {
+	mean := sum(slice) / float64(len(slice))
+	total := 0.0
+	for _, value := range slice {
+		total += (value - mean) * (value - mean)
+	}
+	return total
+}
@@ -0,0 +1,214 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package array
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestPresenter_Histogram(t *testing.T) {
+	histogram, err := New(1, 2, 2, 3, 4, 5).Present().Histogram(2)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := []Bin{{1, 3, 3}, {3, 5, 3}}
+	if !reflect.DeepEqual(histogram, expected) {
+		t.Error("array.Present().Histogram() does not have expected values")
+		t.Errorf("Expecting %v, got %v", expected, histogram)
+		return
+	}
+	histogram, _ = New(7, 7).Present().Histogram(3)
+	if histogram[0].Count != 2 {
+		t.Error("array.Present().Histogram() of equal values does not match")
+		t.Errorf("Expecting %v, got %v", 2, histogram[0].Count)
+		return
+	}
+	if _, err := New(1).Present().Histogram(0); err != InvalidSizeError {
+		t.Error("array.Present().Histogram() error does not match")
+		t.Errorf("Expecting %v, got %v", InvalidSizeError, err)
+		return
+	}
+	for _, value := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		if _, err := New(1, value).Present().Histogram(2); err != NonFiniteElementError {
+			t.Error("array.Present().Histogram() non-finite error does not match")
+			t.Errorf("Expecting %v, got %v", NonFiniteElementError, err)
+			return
+		}
+	}
+	histogram, _ = New(-math.MaxFloat64, math.MaxFloat64).Present().Histogram(2)
+	if histogram[0].Count != 1 || histogram[1].Count != 1 {
+		t.Error("array.Present().Histogram() of the widest range does not match")
+		t.Errorf("Expecting %v, got %v", 1, histogram[0].Count)
+		return
+	}
+}
+
+func TestPresenter_Max(t *testing.T) {
+	max, err := New(3, -1, 4.5, uint8(2)).Present().Max()
+	if err != nil || max != 4.5 {
+		t.Error("array.Present().Max() does not match")
+		t.Errorf("Expecting %v, got %v", 4.5, max)
+		return
+	}
+	if _, err := New().Present().Max(); err != EmptyArrayError {
+		t.Error("array.Present().Max() error does not match")
+		t.Errorf("Expecting %v, got %v", EmptyArrayError, err)
+		return
+	}
+}
+
+func TestPresenter_Mean(t *testing.T) {
+	mean, err := New(1, 2, 3, 4).Present().Mean()
+	if err != nil || mean != 2.5 {
+		t.Error("array.Present().Mean() does not match")
+		t.Errorf("Expecting %v, got %v", 2.5, mean)
+		return
+	}
+	if _, err := New(1, "a").Present().Mean(); err != ElementTypeNotFloat64Error {
+		t.Error("array.Present().Mean() error does not match")
+		t.Errorf("Expecting %v, got %v", ElementTypeNotFloat64Error, err)
+		return
+	}
+}
+
+func TestPresenter_Median(t *testing.T) {
+	median, err := New(5, 1, 3).Present().Median()
+	if err != nil || median != 3 {
+		t.Error("array.Present().Median() does not match")
+		t.Errorf("Expecting %v, got %v", 3, median)
+		return
+	}
+	median, _ = New(4, 1, 3, 2).Present().Median()
+	if median != 2.5 {
+		t.Error("array.Present().Median() of even length does not match")
+		t.Errorf("Expecting %v, got %v", 2.5, median)
+		return
+	}
+}
+
+func TestPresenter_Min(t *testing.T) {
+	min, err := New(3, -1, 4.5).Present().Min()
+	if err != nil || min != -1 {
+		t.Error("array.Present().Min() does not match")
+		t.Errorf("Expecting %v, got %v", -1, min)
+		return
+	}
+}
+
+func TestPresenter_Mode(t *testing.T) {
+	modes, err := New(3, 1, 3, 2, 1).Present().Mode()
+	expected := []float64{1, 3}
+	if err != nil || !reflect.DeepEqual(modes, expected) {
+		t.Error("array.Present().Mode() does not match")
+		t.Errorf("Expecting %v, got %v", expected, modes)
+		return
+	}
+}
+
+func TestPresenter_Percentile(t *testing.T) {
+	presenter := New(4, 1, 3, 2).Present()
+	tests := []struct {
+		interpolation Interpolation
+		expected      float64
+	}{
+		{LinearInterpolation, 1.75},
+		{LowerInterpolation, 1},
+		{HigherInterpolation, 2},
+		{NearestInterpolation, 2},
+		{MidpointInterpolation, 1.5},
+	}
+	for _, test := range tests {
+		percentile, err := presenter.Percentile(25, test.interpolation)
+		if err != nil || percentile != test.expected {
+			t.Error("array.Present().Percentile() does not match")
+			t.Errorf("Expecting %v, got %v", test.expected, percentile)
+			return
+		}
+	}
+	if _, err := presenter.Percentile(101, LinearInterpolation); err != InvalidPercentileError {
+		t.Error("array.Present().Percentile() error does not match")
+		t.Errorf("Expecting %v, got %v", InvalidPercentileError, err)
+		return
+	}
+	if _, err := presenter.Percentile(50, Interpolation(-1)); err != InvalidInterpolationError {
+		t.Error("array.Present().Percentile() interpolation error does not match")
+		t.Errorf("Expecting %v, got %v", InvalidInterpolationError, err)
+		return
+	}
+}
+
+func TestPresenter_SampleStdDev(t *testing.T) {
+	stdDev, err := New(2, 4, 4, 4, 5, 5, 7, 9).Present().SampleStdDev()
+	expected := math.Sqrt(32.0 / 7)
+	if err != nil || stdDev != expected {
+		t.Error("array.Present().SampleStdDev() does not match")
+		t.Errorf("Expecting %v, got %v", expected, stdDev)
+		return
+	}
+}
+
+func TestPresenter_SampleVariance(t *testing.T) {
+	if _, err := New(1).Present().SampleVariance(); err != NotEnoughElementsError {
+		t.Error("array.Present().SampleVariance() error does not match")
+		t.Errorf("Expecting %v, got %v", NotEnoughElementsError, err)
+		return
+	}
+}
+
+func TestPresenter_StdDev(t *testing.T) {
+	stdDev, err := New(2, 4, 4, 4, 5, 5, 7, 9).Present().StdDev()
+	if err != nil || stdDev != 2 {
+		t.Error("array.Present().StdDev() does not match")
+		t.Errorf("Expecting %v, got %v", 2, stdDev)
+		return
+	}
+}
+
+func TestPresenter_Sum(t *testing.T) {
+	sum, err := New(1, 2.5, uint(3)).Present().Sum()
+	if err != nil || sum != 6.5 {
+		t.Error("array.Present().Sum() does not match")
+		t.Errorf("Expecting %v, got %v", 6.5, sum)
+		return
+	}
+}
+
+func TestPresenter_SumInt64(t *testing.T) {
+	sum, err := New(int64(math.MaxInt64-1), 1, int8(-5)).Present().SumInt64()
+	if err != nil || sum != math.MaxInt64-5 {
+		t.Error("array.Present().SumInt64() does not match")
+		t.Errorf("Expecting %v, got %v", int64(math.MaxInt64-5), sum)
+		return
+	}
+	errorTests := []struct {
+		array    Array
+		expected error
+	}{
+		{New(int64(math.MaxInt64), 1), Int64OverflowError},
+		{New(int64(math.MinInt64), -1), Int64OverflowError},
+		{New(uint64(math.MaxUint64)), Int64OverflowError},
+		{New(1, 1.5), ElementTypeNotInt64Error},
+	}
+	for _, test := range errorTests {
+		if _, err := test.array.Present().SumInt64(); err != test.expected {
+			t.Error("array.Present().SumInt64() error does not match")
+			t.Errorf("Expecting %v, got %v", test.expected, err)
+			return
+		}
+	}
+}
+
+func TestPresenter_Variance(t *testing.T) {
+	variance, err := New(2, 4, 4, 4, 5, 5, 7, 9).Present().Variance()
+	if err != nil || variance != 4 {
+		t.Error("array.Present().Variance() does not match")
+		t.Errorf("Expecting %v, got %v", 4, variance)
+		return
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package array
+
+import (
+	"reflect"
+
+	"github.com/with-go/standard/internal/comparison"
+)
+
+// The Difference() function returns a new Array with the elements of the
+// Array that are not included in any of the other Arrays, keeping their
+// order and their duplicates. Elements are compared the same way as
+// Includes() function does, except that elements which cannot be compared
+// using the == operator, such as slices and maps, are compared using the
+// DeepEqual() function from the "reflect" package.
+func (array Array) Difference(others ...Array) Array {
+	excluded := newLookup()
+	for _, other := range others {
+		excluded.add(other...)
+	}
+	return array.exclude(excluded)
+}
+
+// The DifferenceFunc() function is the same as Difference() function, but
+// compares the elements using the provided function. Unlike Difference()
+// function, it takes quadratic time.
+func (array Array) DifferenceFunc(function EqualFunc, others ...Array) Array {
+	return array.WithoutFunc(function, Array{}.Concat(others...)...)
+}
+
+// The Intersect() function returns a new Array with the unique elements of
+// the Array that are included in every other Array, in the order they first
+// appear in the Array. Elements are compared the same way as Difference()
+// function does.
+func (array Array) Intersect(others ...Array) Array {
+	lookups := make([]*lookup, len(others))
+	for index, other := range others {
+		lookups[index] = newLookup(other...)
+	}
+	return array.Unique().Filter(func(_ Array, _ int, value interface{}) bool {
+		for _, lookup := range lookups {
+			if !lookup.has(value) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// The IntersectFunc() function is the same as Intersect() function, but
+// compares the elements using the provided function. Unlike Intersect()
+// function, it takes quadratic time.
+func (array Array) IntersectFunc(function EqualFunc, others ...Array) Array {
+	return array.UniqueFunc(function).Filter(func(_ Array, _ int, value interface{}) bool {
+		for _, other := range others {
+			if other.indexFunc(function, value) == -1 {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// The Union() function returns a new Array with the unique elements of the
+// Array followed by the unique elements of the other Arrays that are not
+// already included, in the order they first appear. Elements are compared
+// the same way as Difference() function does.
+func (array Array) Union(others ...Array) Array {
+	return array.Concat(others...).Unique()
+}
+
+// The UnionFunc() function is the same as Union() function, but compares
+// the elements using the provided function. Unlike Union() function, it
+// takes quadratic time.
+func (array Array) UnionFunc(function EqualFunc, others ...Array) Array {
+	return array.Concat(others...).UniqueFunc(function)
+}
+
+// The Unique() function returns a new Array with the first occurrence of
+// each element of the Array, keeping their order. Elements are compared the
+// same way as Difference() function does.
+func (array Array) Unique() Array {
+	seen := newLookup()
+	unique := Array{}
+	for _, value := range array {
+		if seen.add(value) {
+			unique.PushInPlace(value)
+		}
+	}
+	return unique
+}
+
+// The UniqueBy() function is the same as Unique() function, but compares
+// the keys returned by the provided function for each element instead of
+// the elements themselves. The first element of each key is kept.
+func (array Array) UniqueBy(function KeyFunc) Array {
+	seen := newLookup()
+	unique := Array{}
+	for index, value := range array {
+		if seen.add(function(array, index, value)) {
+			unique.PushInPlace(value)
+		}
+	}
+	return unique
+}
+
+// The UniqueFunc() function is the same as Unique() function, but compares
+// the elements using the provided function. Unlike Unique() function, it
+// takes quadratic time.
+func (array Array) UniqueFunc(function EqualFunc) Array {
+	unique := Array{}
+	for _, value := range array {
+		if unique.indexFunc(function, value) == -1 {
+			unique.PushInPlace(value)
+		}
+	}
+	return unique
+}
+
+// The Without() function returns a new Array with the elements of the
+// Array that are not one of the given values, keeping their order and their
+// duplicates. Elements are compared the same way as Difference() function
+// does.
+func (array Array) Without(values ...interface{}) Array {
+	return array.exclude(newLookup(values...))
+}
+
+// The WithoutFunc() function is the same as Without() function, but
+// compares the elements using the provided function. Unlike Without()
+// function, it takes quadratic time.
+func (array Array) WithoutFunc(function EqualFunc, values ...interface{}) Array {
+	return array.Filter(func(_ Array, _ int, value interface{}) bool {
+		return Array(values).indexFunc(function, value) == -1
+	})
+}
+
+func (array Array) exclude(excluded *lookup) Array {
+	return array.Filter(func(_ Array, _ int, value interface{}) bool {
+		return !excluded.has(value)
+	})
+}
+
+func (array Array) indexFunc(function EqualFunc, value interface{}) int {
+	for index, element := range array {
+		if function(element, value) {
+			return index
+		}
+	}
+	return -1
+}
+
+// lookup answers whether a value has been added in constant time for the
+// values that can be compared using the == operator, and in linear time in
+// the number of values of the same type for the others.
+type lookup struct {
+	values map[interface{}]struct{}
+	others map[reflect.Type][]interface{}
+}
+
+func newLookup(values ...interface{}) *lookup {
+	lookup := &lookup{values: make(map[interface{}]struct{})}
+	lookup.add(values...)
+	return lookup
+}
+
+// The add() function adds the given values, and returns whether the last
+// value was not added before.
+func (lookup *lookup) add(values ...interface{}) bool {
+	isAdded := false
+	for _, value := range values {
+		isAdded = !lookup.has(value)
+		if !isAdded {
+			continue
+		}
+		if comparison.IsComparable(value) {
+			lookup.values[value] = struct{}{}
+			continue
+		}
+		if lookup.others == nil {
+			lookup.others = make(map[reflect.Type][]interface{})
+		}
+		valueType := reflect.TypeOf(value)
+		lookup.others[valueType] = append(lookup.others[valueType], value)
+	}
+	return isAdded
+}
+
+func (lookup *lookup) has(value interface{}) bool {
+	if comparison.IsComparable(value) {
+		_, exists := lookup.values[value]
+		return exists
+	}
+	for _, other := range lookup.others[reflect.TypeOf(value)] {
+		if reflect.DeepEqual(other, value) {
+			return true
+		}
+	}
+	return false
+}
+
+type EqualFunc func (a interface{}, b interface{}) bool
+type KeyFunc func (array Array, index int, value interface{}) interface{}
@@ -0,0 +1,136 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package array
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func equalFold(a interface{}, b interface{}) bool {
+	return strings.EqualFold(a.(string), b.(string))
+}
+
+func TestArray_Difference(t *testing.T) {
+	difference := New(1, 2, 3, 1, []int{4}, []int{5}).Difference(New(2), New(3, []int{4}))
+	expected := New(1, 1, []int{5})
+	if !difference.DeepEqual(expected) {
+		t.Error("array.Difference() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, difference)
+		return
+	}
+}
+
+func TestArray_DifferenceFunc(t *testing.T) {
+	difference := New("a", "B", "c").DifferenceFunc(equalFold, New("A"), New("b"))
+	expected := New("c")
+	if !difference.Equal(expected) {
+		t.Error("array.DifferenceFunc() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, difference)
+		return
+	}
+}
+
+func TestArray_Intersect(t *testing.T) {
+	intersection := New(3, 1, 2, 3, 1).Intersect(New(1, 2, 3), New(3, 1))
+	expected := New(3, 1)
+	if !intersection.Equal(expected) {
+		t.Error("array.Intersect() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, intersection)
+		return
+	}
+}
+
+func TestArray_IntersectFunc(t *testing.T) {
+	intersection := New("a", "b", "A").IntersectFunc(equalFold, New("B", "A"))
+	expected := New("a", "b")
+	if !intersection.Equal(expected) {
+		t.Error("array.IntersectFunc() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, intersection)
+		return
+	}
+}
+
+func TestArray_Union(t *testing.T) {
+	original := New(1, 2, 1)
+	union := original.Union(New(3, 2), New(4, 1))
+	expected := New(1, 2, 3, 4)
+	if !union.Equal(expected) || !original.Equal(New(1, 2, 1)) {
+		t.Error("array.Union() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, union)
+		return
+	}
+}
+
+func TestArray_UnionFunc(t *testing.T) {
+	union := New("a", "b").UnionFunc(equalFold, New("A", "c"))
+	expected := New("a", "b", "c")
+	if !union.Equal(expected) {
+		t.Error("array.UnionFunc() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, union)
+		return
+	}
+}
+
+func TestArray_Unique(t *testing.T) {
+	unique := New(1, int64(1), "1", 1, nil, nil, map[string]int{"a": 1}, map[string]int{"a": 1}).Unique()
+	expected := New(1, int64(1), "1", nil, map[string]int{"a": 1})
+	if !unique.DeepEqual(expected) {
+		t.Error("array.Unique() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, unique)
+		return
+	}
+	nan := math.NaN()
+	if length := New(nan, nan).Unique().Length(); length != 2 {
+		t.Error("array.Unique() NaN does not match")
+		t.Errorf("Expecting %v, got %v", 2, length)
+		return
+	}
+}
+
+func TestArray_UniqueBy(t *testing.T) {
+	unique := New("apple", "avocado", "banana", "cherry", "blueberry").UniqueBy(func(array Array, index int, value interface{}) interface{} {
+		return value.(string)[0]
+	})
+	expected := New("apple", "banana", "cherry")
+	if !unique.Equal(expected) {
+		t.Error("array.UniqueBy() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, unique)
+		return
+	}
+}
+
+func TestArray_UniqueFunc(t *testing.T) {
+	unique := New("a", "A", "b", "B", "a").UniqueFunc(equalFold)
+	expected := New("a", "b")
+	if !unique.Equal(expected) {
+		t.Error("array.UniqueFunc() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, unique)
+		return
+	}
+}
+
+func TestArray_Without(t *testing.T) {
+	without := New(1, 2, 3, 2, []int{1}).Without(2, []int{1})
+	expected := New(1, 3)
+	if !reflect.DeepEqual(without, expected) {
+		t.Error("array.Without() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, without)
+		return
+	}
+}
+
+func TestArray_WithoutFunc(t *testing.T) {
+	without := New("a", "B", "c").WithoutFunc(equalFold, "b")
+	expected := New("a", "c")
+	if !without.Equal(expected) {
+		t.Error("array.WithoutFunc() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, without)
+		return
+	}
+}
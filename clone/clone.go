@@ -0,0 +1,137 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package clone
+
+import (
+	"reflect"
+)
+
+// DeepCloner defines a value that knows how to copy itself, such as a
+// Collection, whose fields cannot be copied using reflection.
+type DeepCloner interface {
+	// DeepCloneWith() returns a deep copy of the value. It has to call
+	// Remember() function with the copy before copying any element, and
+	// copy each element using Clone() function of the given Cloner.
+	DeepCloneWith(cloner *Cloner) interface{}
+}
+
+// The Deep() function returns a deep copy of the given value. See "clone"
+// package documentation for more information.
+func Deep(v interface{}) interface{} {
+	return (&Cloner{}).Clone(v)
+}
+
+// Cloner keeps the copies that have been made during a deep copy, so a
+// value that appears more than once is copied only once. The zero value is
+// ready to use.
+type Cloner struct {
+	copies map[reference]interface{}
+}
+
+// reference identifies the memory of a value. A slice is identified by its
+// length as well, so a slice and a shorter slice of it are different.
+type reference struct {
+	kind    reflect.Kind
+	pointer uintptr
+	length  int
+}
+
+// The Clone() function returns a deep copy of the given value, or the copy
+// that has already been made of it.
+func (cloner *Cloner) Clone(v interface{}) interface{} {
+	key, ok := referenceOf(v)
+	if ok {
+		if existing, ok := cloner.copies[key]; ok {
+			return existing
+		}
+	}
+	if deepCloner, ok := v.(DeepCloner); ok && !isNil(v) {
+		return deepCloner.DeepCloneWith(cloner)
+	}
+	reflection := reflect.ValueOf(v)
+	switch reflection.Kind() {
+	case reflect.Slice:
+		if reflection.IsNil() {
+			return v
+		}
+		cloned := reflect.MakeSlice(reflection.Type(), reflection.Len(), reflection.Len())
+		cloner.Remember(v, cloned.Interface())
+		for index := 0; index < reflection.Len(); index++ {
+			cloner.set(cloned.Index(index), reflection.Index(index))
+		}
+		return cloned.Interface()
+	case reflect.Array:
+		cloned := reflect.New(reflection.Type()).Elem()
+		for index := 0; index < reflection.Len(); index++ {
+			cloner.set(cloned.Index(index), reflection.Index(index))
+		}
+		return cloned.Interface()
+	case reflect.Map:
+		if reflection.IsNil() {
+			return v
+		}
+		cloned := reflect.MakeMapWithSize(reflection.Type(), reflection.Len())
+		cloner.Remember(v, cloned.Interface())
+		iterator := reflection.MapRange()
+		for iterator.Next() {
+			element := reflect.New(reflection.Type().Elem()).Elem()
+			cloner.set(element, iterator.Value())
+			cloned.SetMapIndex(iterator.Key(), element)
+		}
+		return cloned.Interface()
+	}
+	return v
+}
+
+// The Remember() function records the copy of the original value, so the
+// original is not copied again when it appears another time.
+func (cloner *Cloner) Remember(original interface{}, copy interface{}) {
+	key, ok := referenceOf(original)
+	if !ok {
+		return
+	}
+	if cloner.copies == nil {
+		cloner.copies = make(map[reference]interface{})
+	}
+	cloner.copies[key] = copy
+}
+
+// The set() function sets the copy of the source into the target. Only an
+// element of an interface type can hold a copy that is a different value
+// than the source, so the elements of other types are copied as they are,
+// unless they are slices, arrays or maps.
+func (cloner *Cloner) set(target reflect.Value, source reflect.Value) {
+	if source.Kind() == reflect.Interface && source.IsNil() {
+		return
+	}
+	cloned := cloner.Clone(source.Interface())
+	if cloned == nil {
+		return
+	}
+	target.Set(reflect.ValueOf(cloned))
+}
+
+func referenceOf(value interface{}) (reference, bool) {
+	reflection := reflect.ValueOf(value)
+	switch reflection.Kind() {
+	case reflect.Map, reflect.Ptr:
+		if reflection.IsNil() {
+			return reference{}, false
+		}
+		return reference{kind: reflection.Kind(), pointer: reflection.Pointer()}, true
+	case reflect.Slice:
+		if reflection.Len() == 0 {
+			return reference{}, false
+		}
+		return reference{kind: reflect.Slice, pointer: reflection.Pointer(), length: reflection.Len()}, true
+	}
+	return reference{}, false
+}
+
+func isNil(value interface{}) bool {
+	reflection := reflect.ValueOf(value)
+	return reflection.Kind() == reflect.Ptr && reflection.IsNil()
+}
@@ -0,0 +1,101 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package clone
+
+import (
+	"reflect"
+	"testing"
+)
+
+type node struct {
+	values []interface{}
+}
+
+func (n *node) DeepCloneWith(cloner *Cloner) interface{} {
+	cloned := &node{}
+	cloner.Remember(n, cloned)
+	cloned.values = cloner.Clone(n.values).([]interface{})
+	return cloned
+}
+
+func TestDeep(t *testing.T) {
+	original := map[string]interface{}{
+		"slice":  []interface{}{1, "a", []int{2, 3}},
+		"map":    map[string]int{"b": 4},
+		"array":  [2][]int{{5}, {6}},
+		"string": "c",
+		"nil":    nil,
+	}
+	cloned := Deep(original).(map[string]interface{})
+	if !reflect.DeepEqual(original, cloned) {
+		t.Error("clone.Deep() value does not match")
+		t.Errorf("Expecting %v, got %v", original, cloned)
+		return
+	}
+	cloned["slice"].([]interface{})[2].([]int)[0] = 7
+	cloned["map"].(map[string]int)["b"] = 8
+	cloned["array"].([2][]int)[0][0] = 9
+	if original["slice"].([]interface{})[2].([]int)[0] != 2 ||
+		original["map"].(map[string]int)["b"] != 4 ||
+		original["array"].([2][]int)[0][0] != 5 {
+		t.Error("clone.Deep() value is shared with the original")
+		t.Errorf("Got %v", original)
+		return
+	}
+}
+
+func TestDeep_shared(t *testing.T) {
+	shared := map[string]interface{}{"a": 1}
+	original := []interface{}{shared, shared}
+	cloned := Deep(original).([]interface{})
+	first, second := cloned[0].(map[string]interface{}), cloned[1].(map[string]interface{})
+	first["a"] = 2
+	if second["a"] != 2 {
+		t.Error("clone.Deep() shared reference does not match")
+		t.Errorf("Expecting %v, got %v", 2, second["a"])
+		return
+	}
+	if shared["a"] != 1 {
+		t.Error("clone.Deep() value is shared with the original")
+		t.Errorf("Expecting %v, got %v", 1, shared["a"])
+		return
+	}
+}
+
+func TestDeep_circular(t *testing.T) {
+	original := map[string]interface{}{"a": 1}
+	original["self"] = original
+	cloned := Deep(original).(map[string]interface{})
+	self := cloned["self"].(map[string]interface{})
+	if reflect.ValueOf(self).Pointer() != reflect.ValueOf(cloned).Pointer() {
+		t.Error("clone.Deep() circular reference does not match")
+		return
+	}
+	if reflect.ValueOf(self).Pointer() == reflect.ValueOf(original).Pointer() {
+		t.Error("clone.Deep() value is shared with the original")
+		return
+	}
+}
+
+func TestDeep_deepCloner(t *testing.T) {
+	original := &node{}
+	original.values = []interface{}{"a", original, map[string]interface{}{"b": original}}
+	cloned := Deep(original).(*node)
+	if cloned == original {
+		t.Error("clone.Deep() value is shared with the original")
+		return
+	}
+	if cloned.values[1] != cloned || cloned.values[2].(map[string]interface{})["b"] != cloned {
+		t.Error("clone.Deep() circular reference does not match")
+		t.Errorf("Expecting %p, got %v", cloned, cloned.values)
+		return
+	}
+	var empty *node
+	if Deep(empty).(*node) != nil {
+		t.Error("clone.Deep() nil value does not match")
+		return
+	}
+}
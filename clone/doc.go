@@ -0,0 +1,17 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+/*
+The Clone makes deep copies of nested values, the same way as the
+structuredClone() function of JavaScript does. Slices, arrays and maps,
+including Arrays and Objects, are copied with their elements, and a value
+that implements DeepCloner, such as a Collection, copies itself.
+
+A value that appears more than once is copied only once, so the copy holds
+the same shared references as the original, and a circular value is copied
+into a copy that refers to itself. Pointers, structs and any other value
+are not copied, so the copy holds the same value as the original.
+*/
+package clone
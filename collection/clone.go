@@ -0,0 +1,44 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package collection
+
+import (
+	"github.com/with-go/standard/clone"
+)
+
+// The Clone() function returns a shallow copy of the Collection, which holds
+// the same keys and values as the Collection in the same order, each in a
+// new Pair{}. A nested Array, Object or Collection is shared with the
+// Collection. Use DeepClone() function to copy them as well. The handlers
+// registered using OnChange() function are not copied.
+func (collection *Collection) Clone() *Collection {
+	if collection == nil {
+		return nil
+	}
+	return collection.Slice(0, len(collection.pairs))
+}
+
+// The DeepClone() function returns a deep copy of the Collection, which also
+// copies the nested Arrays, Objects, Collections, native slices and native
+// maps in the same order, the same way as the Deep() function of "clone"
+// package does. An element that appears more than once in the Collection,
+// including an element that holds the Collection itself, is copied only
+// once, so the copy keeps the same shared and circular references. The
+// handlers registered using OnChange() function are not copied.
+func (collection *Collection) DeepClone() *Collection {
+	return clone.Deep(collection).(*Collection)
+}
+
+// The DeepCloneWith() function implements clone.DeepCloner, so a nested
+// Collection is copied by the Deep() function of "clone" package.
+func (collection *Collection) DeepCloneWith(cloner *clone.Cloner) interface{} {
+	cloned := New()
+	cloner.Remember(collection, cloned)
+	for _, pair := range collection.pairs {
+		cloned.insertPair(len(cloned.pairs), &Pair{ key: pair.key, value: cloner.Clone(pair.value) })
+	}
+	return cloned
+}
@@ -0,0 +1,79 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package collection
+
+import (
+	"testing"
+
+	"github.com/with-go/standard/array"
+	"github.com/with-go/standard/object"
+)
+
+func TestCollection_Clone(t *testing.T) {
+	nested := New().Set("a", 1)
+	collection := New().Set("z", "b").Set(1, nested)
+	collection.OnChange(func(events []Event) {
+		t.Error("collection.Clone() handler is shared with the original")
+	})
+	cloned := collection.Clone()
+	cloned.Set("z", "c")
+	if collection.Get("z") != "b" {
+		t.Error("collection.Clone() value is shared with the original")
+		t.Errorf("Expecting %v, got %v", "b", collection.Get("z"))
+		return
+	}
+	if cloned.String() != "{\"z\":\"c\",\"1\":{\"a\":1}}" {
+		t.Error("collection.Clone() order does not match")
+		t.Errorf("Expecting %v, got %v", "{\"z\":\"c\",\"1\":{\"a\":1}}", cloned.String())
+		return
+	}
+	if cloned.Get(1) != nested {
+		t.Error("collection.Clone() nested value does not match")
+		return
+	}
+	var empty *Collection
+	if empty.Clone() != nil {
+		t.Error("collection.Clone() nil value does not match")
+		return
+	}
+}
+
+func TestCollection_DeepClone(t *testing.T) {
+	nested := New().Set("a", 1)
+	collection := New().
+		Set("z", nested).
+		Set("y", nested).
+		Set("array", array.New(2, nested)).
+		Set("object", object.New().Set("b", 3))
+	collection.Set("self", collection)
+	cloned := collection.DeepClone()
+	if cloned.String() != collection.String() {
+		t.Error("collection.DeepClone() value does not match")
+		t.Errorf("Expecting %v, got %v", collection.String(), cloned.String())
+		return
+	}
+	cloned.Get("z").(*Collection).Set("a", 4)
+	cloned.Get("object").(object.Object)["b"] = 5
+	if nested.Get("a") != 1 || collection.Get("object").(object.Object)["b"] != 3 {
+		t.Error("collection.DeepClone() value is shared with the original")
+		t.Errorf("Got %v", collection.String())
+		return
+	}
+	if cloned.Get("y") != cloned.Get("z") || cloned.Get("array").(array.Array)[1] != cloned.Get("z") {
+		t.Error("collection.DeepClone() shared reference does not match")
+		t.Errorf("Got %v", cloned.String())
+		return
+	}
+	if cloned.Get("self") != cloned {
+		t.Error("collection.DeepClone() circular reference does not match")
+		return
+	}
+	var empty *Collection
+	if empty.DeepClone() != nil {
+		t.Error("collection.DeepClone() nil value does not match")
+		return
+	}
+}
@@ -7,16 +7,21 @@ import (
 	"reflect"
 	"sort"
 	"strings"
+
+	"github.com/with-go/standard/cycle"
 )
 
 var (
+	CorruptDataError = errors.New("the given data is not a valid encoded Collection")
+	NonComparableKeyError = errors.New("the given key is a non-comparable type, " +
+		"key should be comparable using the == operator")
 	NonMapTypeError = errors.New("the given parameter v is a non-map type, " +
 		"parameter v should be a map")
 )
 
 // The New() function creates a new Collection.
 func New() *Collection {
-	return &Collection{ make([]*Pair, 0) }
+	return &Collection{ pairs: make([]*Pair, 0) }
 }
 
 // The NewFromJsonString() function parses a given JSON string, and returns
@@ -38,8 +43,10 @@ func NewFromJsonString(v string) (*Collection, error) {
 // The NewFromMap() function returns a new Collection from a given map. Because
 // the Go native map does not support element ordering, the returned
 // Collection will not have the same order as defined in the map. Instead, the
-// key ordering will be sorted alphabetically. If the given parameter v is not
-// a map, it will returns NonMapTypeError.
+// key ordering will be sorted alphabetically, or by value if the keys are
+// numbers. The keys keep their native type, so a map with int keys returns
+// a Collection with int keys. If the given parameter v is not a map, it will
+// returns NonMapTypeError.
 //
 // Internally, this function will replaces any occurrence of child element with
 // map type and replaces it to *Collection. So if the map v contains an element
@@ -50,29 +57,44 @@ func NewFromJsonString(v string) (*Collection, error) {
 //
 // For any map element that is not map or map pointer, it will be saved as it is.
 func NewFromMap(v interface{}) (*Collection, error) {
+	return newFromMap(v, make(map[uintptr]*Collection))
+}
+
+// The newFromMap() function converts the map the same way as NewFromMap()
+// function does. A map that appears more than once, including a map that
+// holds itself, is converted into a single Collection, so the returned
+// Collection holds the same circular references instead of recursing
+// forever.
+func newFromMap(v interface{}, converted map[uintptr]*Collection) (*Collection, error) {
 	valueOfV := reflect.ValueOf(v)
 	if valueOfV.Kind() != reflect.Map {
 		return nil, NonMapTypeError
 	}
-	keys := make([]string, valueOfV.Len())
-	for index, key := range valueOfV.MapKeys() {
-		keys[index] = key.String()
+	address := valueOfV.Pointer()
+	if existing, ok := converted[address]; ok && address != 0 {
+		return existing, nil
 	}
-	sort.Strings(keys)
+	keys := valueOfV.MapKeys()
+	sort.SliceStable(keys, func(i, j int) bool {
+		return lessKey(keys[i].Interface(), keys[j].Interface())
+	})
 	collection := New()
+	if address != 0 {
+		converted[address] = collection
+	}
 	for _, key := range keys {
-		pair := Pair{ key: key }
-		value := reflect.ValueOf(valueOfV.MapIndex(reflect.ValueOf(key)).Interface())
+		pair := Pair{ key: key.Interface() }
+		value := reflect.ValueOf(valueOfV.MapIndex(key).Interface())
 		switch value.Kind() {
 		case reflect.Map:
-			convertedValue, err := NewFromMap(value.Interface())
+			convertedValue, err := newFromMap(value.Interface(), converted)
 			if err != nil {
 				return nil, err
 			}
 			pair.value = convertedValue
 			break
 		case reflect.Ptr:
-			convertedValue, err := NewFromMap(value.Elem().Interface())
+			convertedValue, err := newFromMap(value.Elem().Interface(), converted)
 			if err != nil {
 				return nil, err
 			}
@@ -81,15 +103,39 @@ func NewFromMap(v interface{}) (*Collection, error) {
 		default:
 			pair.value = value.Interface()
 		}
-		collection.pairs = append(collection.pairs, &pair)
+		collection.insertPair(len(collection.pairs), &pair)
 	}
 	return collection, nil
 }
 
+// The NewFromPairs() function returns a new Collection that holds the key
+// and value of each given Pair{}, in the given order. The Pair{} itself is
+// not shared with the new Collection. If a key is given more than once, the
+// element keeps the position of the first Pair{} and the value of the last
+// Pair{}, the same way as Set() function does.
+func NewFromPairs(pairs ...*Pair) *Collection {
+	collection := New()
+	for _, pair := range pairs {
+		if pair != nil {
+			collection.Set(pair.key, pair.value)
+		}
+	}
+	return collection
+}
+
+// The NewPair() function creates a new Pair{} with the given key and value,
+// which does not belong to any Collection yet. See NewFromPairs() function.
+func NewPair(key interface{}, value interface{}) *Pair {
+	checkKey(key)
+	return &Pair{ key: key, value: value }
+}
+
 // Collection defines a Collection Type. See "collection" package documentation
 // for more information.
 type Collection struct {
-	pairs	[]*Pair
+	pairs		[]*Pair
+	observation	*observation
+	snapshot	*Snapshot
 }
 
 // The Add() function adds or updates an element with a specified key and value
@@ -100,27 +146,59 @@ type Collection struct {
 // key-value pair by deleting current key-value pair and then insert a new
 // key-value pair, thus changing the order of insertion. See Set() function
 // to update the value and thus will NOT change the order of insertion.
-func (collection *Collection) Add(key string, value interface{}) *Collection {
+func (collection *Collection) Add(key interface{}, value interface{}) *Collection {
+	checkKey(key)
 	collection.Delete(key)
-	collection.pairs = append(collection.pairs, &Pair{ key, value })
+	collection.insertPair(len(collection.pairs), &Pair{ key: key, value: value })
+	collection.emit(AddEvent, key, nil, value)
 	return collection
 }
 
+// The At() function returns a pointer to the Pair{} at the given index of
+// the insertion order. A negative index counts back from the end of the
+// Collection, so At(-1) returns the last Pair{}. If the index is out of
+// range, it will returns nil.
+func (collection *Collection) At(index int) *Pair {
+	if index < 0 {
+		index += len(collection.pairs)
+	}
+	if index < 0 || index >= len(collection.pairs) {
+		return nil
+	}
+	return collection.pairs[index]
+}
+
 // The Clear() function removes all elements from the Collection.
 func (collection *Collection) Clear() *Collection {
+	isEmpty := len(collection.pairs) == 0
+	collection.copySnapshot()
+	for _, pair := range collection.pairs {
+		pair.detach()
+		pair.collection = nil
+	}
 	collection.pairs = make([]*Pair, 0)
+	if !isEmpty {
+		collection.emit(ClearEvent, nil, nil, nil)
+	}
 	return collection
 }
 
 // The Delete() function removes the specified element from the Collection by key.
-func (collection *Collection) Delete(key string) *Collection {
+func (collection *Collection) Delete(key interface{}) *Collection {
 	index := collection.IndexOf(key)
 	if index != -1 {
-		collection.pairs = append(collection.pairs[:index], collection.pairs[index+1:]...)
+		pair := collection.removePair(index)
+		collection.emit(DeleteEvent, pair.key, pair.value, nil)
 	}
 	return collection
 }
 
+// The First() function returns a pointer to the first Pair{} of the
+// insertion order. If the Collection is empty, it will returns nil.
+func (collection *Collection) First() *Pair {
+	return collection.At(0)
+}
+
 // The ForEach() function executes a provided function once for each Collection element.
 func (collection *Collection) ForEach(function ForEachFunc) {
 	for _, pair := range collection.pairs {
@@ -130,7 +208,7 @@ func (collection *Collection) ForEach(function ForEachFunc) {
 
 // The Get() function returns a specified element from the Collection.
 // If the element with the given key does not exist, it will returns nil.
-func (collection *Collection) Get(key string) interface{} {
+func (collection *Collection) Get(key interface{}) interface{} {
 	pair := collection.PairOf(key)
 	if pair == nil {
 		return nil
@@ -140,15 +218,15 @@ func (collection *Collection) Get(key string) interface{} {
 
 // The Has() function returns a boolean indicating whether an element with
 // the specified key exists or not.
-func (collection *Collection) Has(key string) bool {
+func (collection *Collection) Has(key interface{}) bool {
 	return collection.PairOf(key) != nil
 }
 
 // The HasAll() function is the same as Has() function, but accepts a slice
-// of keys instead of a single key string. If the object has all the element
+// of keys instead of a single key. If the object has all the element
 // for each given key, it will returns true. Otherwise, it will returns
 // false.
-func (collection *Collection) HasAll(keys ...string) bool {
+func (collection *Collection) HasAll(keys ...interface{}) bool {
 	for _, key := range keys {
 		if !collection.Has(key) {
 			return false
@@ -158,10 +236,10 @@ func (collection *Collection) HasAll(keys ...string) bool {
 }
 
 // The HasSome() function is the same as Has() function, but accepts a slice
-// of keys instead of a single key string. If the object has one or more
+// of keys instead of a single key. If the object has one or more
 // element for each given key, it will returns true. Otherwise, it will
 // returns false.
-func (collection *Collection) HasSome(keys ...string) bool {
+func (collection *Collection) HasSome(keys ...interface{}) bool {
 	for _, key := range keys {
 		if collection.Has(key) {
 			return true
@@ -174,54 +252,166 @@ func (collection *Collection) HasSome(keys ...string) bool {
 // given key. This Pair{} is registered in the internal slice of Pair{}
 // information inside the Collection. If there are no Pair{} registered with
 // the given key, it will returns -1.
-func (collection *Collection) IndexOf(key string) int {
+func (collection *Collection) IndexOf(key interface{}) int {
 	for index, pair := range collection.pairs {
-		if pair.key == key {
+		if keysEqual(pair.key, key) {
 			return index
 		}
 	}
 	return -1
 }
 
-// The Keys() function returns a slice of string that contains the keys
+// The InsertAt() function inserts an element with a specified key and value
+// at the given index of the insertion order. A negative index counts back
+// from the end of the Collection, and an index out of range is clamped to
+// the first or the last position. If an element with the specified key
+// exists, it will be removed first, the same way as Add() function does.
+func (collection *Collection) InsertAt(index int, key interface{}, value interface{}) *Collection {
+	checkKey(key)
+	collection.Delete(key)
+	collection.insertPair(index, &Pair{ key: key, value: value })
+	collection.emit(AddEvent, key, nil, value)
+	return collection
+}
+
+// The Keys() function returns a slice of interface{} that contains the keys
 // for each element in the Collection, based on the insertion order.
-func (collection *Collection) Keys() []string {
-	keys := make([]string, len(collection.pairs))
+func (collection *Collection) Keys() []interface{} {
+	keys := make([]interface{}, len(collection.pairs))
 	for index, pair := range collection.pairs {
 		keys[index] = pair.key
 	}
 	return keys
 }
 
+// The Last() function returns a pointer to the last Pair{} of the
+// insertion order. If the Collection is empty, it will returns nil.
+func (collection *Collection) Last() *Pair {
+	return collection.At(-1)
+}
+
 // The Length() function returns the number of elements contained inside the
 // Collection.
 func (collection *Collection) Length() int {
 	return len(collection.pairs)
 }
 
+// The MoveAfter() function moves the element with the specified key right
+// after the element with the anchor key, without changing its value. If
+// either key does not exist, the Collection is left unchanged.
+func (collection *Collection) MoveAfter(key interface{}, anchor interface{}) *Collection {
+	if keysEqual(key, anchor) || !collection.HasAll(key, anchor) {
+		return collection
+	}
+	collection.movePair(collection.IndexOf(key), func() int {
+		return collection.IndexOf(anchor) + 1
+	})
+	return collection
+}
+
+// The MoveBefore() function moves the element with the specified key right
+// before the element with the anchor key, without changing its value. If
+// either key does not exist, the Collection is left unchanged.
+func (collection *Collection) MoveBefore(key interface{}, anchor interface{}) *Collection {
+	if keysEqual(key, anchor) || !collection.HasAll(key, anchor) {
+		return collection
+	}
+	collection.movePair(collection.IndexOf(key), func() int {
+		return collection.IndexOf(anchor)
+	})
+	return collection
+}
+
+// The MoveToBack() function moves the element with the specified key to the
+// last position of the insertion order. If the key does not exist, the
+// Collection is left unchanged.
+func (collection *Collection) MoveToBack(key interface{}) *Collection {
+	if index := collection.IndexOf(key); index != -1 {
+		collection.movePair(index, collection.Length)
+	}
+	return collection
+}
+
+// The MoveToFront() function moves the element with the specified key to
+// the first position of the insertion order. If the key does not exist, the
+// Collection is left unchanged.
+func (collection *Collection) MoveToFront(key interface{}) *Collection {
+	if index := collection.IndexOf(key); index != -1 {
+		collection.movePair(index, func() int {
+			return 0
+		})
+	}
+	return collection
+}
+
+// The NextKey() function returns the key that comes right after the given
+// key in the insertion order. If the given key does not exist or it is the
+// last key, it will returns nil and false.
+func (collection *Collection) NextKey(key interface{}) (interface{}, bool) {
+	index := collection.IndexOf(key)
+	if index == -1 || index == len(collection.pairs)-1 {
+		return nil, false
+	}
+	return collection.pairs[index+1].key, true
+}
+
 // The PairOf() function returns a pointer to the Pair{} that represent the
 // given key. This Pair{} is registered in the internal slice of Pair{}
 // information inside the Collection. If there are no Pair{} registered with
 // the given key, it will returns nil.
-func (collection *Collection) PairOf(key string) *Pair {
+func (collection *Collection) PairOf(key interface{}) *Pair {
 	for _, pair := range collection.pairs {
-		if pair.key == key {
+		if keysEqual(pair.key, key) {
 			return pair
 		}
 	}
 	return nil
 }
 
+// The Pairs() function returns a slice of pointer to each Pair{} in the
+// Collection, based on the insertion order. The returned Pair{} is the one
+// registered inside the Collection, so changing its value with SetValue()
+// will be reflected to the Collection, while changing the returned slice
+// itself will not.
+func (collection *Collection) Pairs() []*Pair {
+	pairs := make([]*Pair, len(collection.pairs))
+	copy(pairs, collection.pairs)
+	return pairs
+}
+
+// The Pop() function removes the last element from the Collection and
+// returns its Pair{}, the same way as Python's OrderedDict.popitem() does.
+// If the Collection is empty, it will returns nil.
+func (collection *Collection) Pop() *Pair {
+	if len(collection.pairs) == 0 {
+		return nil
+	}
+	pair := collection.removePair(len(collection.pairs) - 1)
+	collection.emit(DeleteEvent, pair.key, pair.value, nil)
+	return pair
+}
+
 // The Present() function returns an Collection Presenter, which capable to
 // returns the the collection to other predefined data type.
 func (collection *Collection) Present() Presenter {
 	return Presenter{ collection }
 }
 
+// The PrevKey() function returns the key that comes right before the given
+// key in the insertion order. If the given key does not exist or it is the
+// first key, it will returns nil and false.
+func (collection *Collection) PrevKey(key interface{}) (interface{}, bool) {
+	index := collection.IndexOf(key)
+	if index <= 0 {
+		return nil, false
+	}
+	return collection.pairs[index-1].key, true
+}
+
 // The Reflect() function returns the Collection value of the given key as a 
 // reflect.Value data. If there is no element saved with the given key, it will
 // returns reflect.Value of nil.
-func (collection *Collection) Reflect(key string) reflect.Value {
+func (collection *Collection) Reflect(key interface{}) reflect.Value {
 	if !collection.Has(key) {
 		return reflect.ValueOf(nil)
 	} 
@@ -229,15 +419,32 @@ func (collection *Collection) Reflect(key string) reflect.Value {
 }
 
 // The Reflects() function returns the map[string]reflect.Value representation
-// of the Collection.
+// of the Collection. Each key is converted using KeyString() function.
 func (collection *Collection) Reflects() map[string]reflect.Value {
 	reflection := make(map[string]reflect.Value)
 	for _, pair := range collection.pairs {
-		reflection[pair.key] = collection.Reflect(pair.key)
+		reflection[KeyString(pair.key)] = collection.Reflect(pair.key)
 	}
 	return reflection
 }
 
+// The Rename() function changes the key of an element without changing its
+// value or its position in the insertion order. If an element with the new
+// key already exists, it will be removed. If the old key does not exist,
+// the Collection is left unchanged.
+func (collection *Collection) Rename(oldKey interface{}, newKey interface{}) *Collection {
+	pair := collection.PairOf(oldKey)
+	if pair == nil || keysEqual(oldKey, newKey) {
+		return collection
+	}
+	checkKey(newKey)
+	collection.Delete(newKey)
+	collection.copySnapshot()
+	pair.key = newKey
+	collection.emit(RenameEvent, newKey, oldKey, newKey)
+	return collection
+}
+
 // The Set() function adds or updates an element with a specified key and value
 // to the Collection. Since the Set() function returns back the same Collection,
 // you can chain the function call.
@@ -246,35 +453,79 @@ func (collection *Collection) Reflects() map[string]reflect.Value {
 // and thus will NOT change the order of insertion. See Add() function
 // to replace the key-value pair by deleting current key-value pair and then
 // insert a new key-value pair, thus changing the order of insertion.
-func (collection *Collection) Set(key string, value interface{}) *Collection {
+func (collection *Collection) Set(key interface{}, value interface{}) *Collection {
 	pair := collection.PairOf(key)
 	if pair != nil {
-		pair.key = key
-		pair.value = value
+		pair.SetValue(value)
 	} else {
-		collection.pairs = append(collection.pairs, &Pair{ key, value })
+		checkKey(key)
+		collection.insertPair(len(collection.pairs), &Pair{ key: key, value: value })
+		collection.emit(AddEvent, key, nil, value)
 	}
 	return collection
 }
 
-// The String() function returns a string representing the specified Collection
-// and its elements.
-func (collection *Collection) String() string {
-	str := fmt.Sprint()
-	for index, pair := range collection.pairs {
-		if index != 0 {
-			str += fmt.Sprint(",")
+// The Shift() function removes the first element from the Collection and
+// returns its Pair{}, the same way as Python's OrderedDict.popitem(False)
+// does. If the Collection is empty, it will returns nil.
+func (collection *Collection) Shift() *Pair {
+	if len(collection.pairs) == 0 {
+		return nil
+	}
+	pair := collection.removePair(0)
+	collection.emit(DeleteEvent, pair.key, pair.value, nil)
+	return pair
+}
+
+// The Slice() function returns a new Collection that holds the elements
+// from the start index up to, but not including, the end index of the
+// insertion order. A negative index counts back from the end of the
+// Collection, and an index out of range is clamped to the valid range. The
+// values are not copied, but changes to the key-value pairs of the new
+// Collection will not be reflected to the Collection.
+func (collection *Collection) Slice(start int, end int) *Collection {
+	clamp := func(index int) int {
+		if index < 0 {
+			index += len(collection.pairs)
 		}
-		str += fmt.Sprintf("\"%s\":", pair.key)
-		switch reflect.ValueOf(pair.value).Kind() {
-		case reflect.String:
-			str += fmt.Sprintf("\"%s\"", pair.value.(string))
-			break
-		default:
-			str += fmt.Sprintf("%v", pair.value)
+		if index < 0 {
+			return 0
+		}
+		if index > len(collection.pairs) {
+			return len(collection.pairs)
 		}
+		return index
+	}
+	sliced := New()
+	for index := clamp(start); index < clamp(end); index++ {
+		pair := collection.pairs[index]
+		sliced.insertPair(len(sliced.pairs), &Pair{ key: pair.key, value: pair.value })
+	}
+	return sliced
+}
+
+// The String() function returns a string representing the specified Collection
+// and its elements. Each key is converted using KeyString() function. If the
+// Collection holds itself, directly or through one of its elements, the
+// circular element is printed as the Marker of "cycle" package.
+func (collection *Collection) String() string {
+	var builder strings.Builder
+	writeValue(&builder, collection, &cycle.Tracker{})
+	return builder.String()
+}
+
+// The Swap() function swaps the positions of the elements with the
+// specified keys in the insertion order. If either key does not exist, the
+// Collection is left unchanged.
+func (collection *Collection) Swap(a interface{}, b interface{}) *Collection {
+	indexOfA, indexOfB := collection.IndexOf(a), collection.IndexOf(b)
+	if indexOfA != -1 && indexOfB != -1 && indexOfA != indexOfB {
+		collection.copySnapshot()
+		collection.pairs[indexOfA], collection.pairs[indexOfB] = collection.pairs[indexOfB], collection.pairs[indexOfA]
+		collection.emit(MoveEvent, collection.pairs[indexOfB].key, indexOfA, indexOfB)
+		collection.emit(MoveEvent, collection.pairs[indexOfA].key, indexOfB, indexOfA)
 	}
-	return fmt.Sprintf("{%s}", str)
+	return collection
 }
 
 // The Values() function returns a Values that contains the values for each element
@@ -287,10 +538,168 @@ func (collection *Collection) Values() []interface{} {
 	return values
 }
 
+// The insertPair() function inserts the given Pair{} at the given index of
+// the internal slice of Pair{}, clamping the index to the valid range. Every
+// Pair{} of the Collection has to be inserted through this function, so it
+// knows the Collection it belongs to.
+func (collection *Collection) insertPair(index int, pair *Pair) {
+	if index < 0 {
+		index += len(collection.pairs)
+	}
+	if index < 0 {
+		index = 0
+	}
+	if index > len(collection.pairs) {
+		index = len(collection.pairs)
+	}
+	collection.copySnapshot()
+	collection.pairs = append(collection.pairs, nil)
+	copy(collection.pairs[index+1:], collection.pairs[index:])
+	collection.pairs[index] = pair
+	pair.collection = collection
+	collection.attach(pair)
+}
+
+// The movePair() function moves the Pair{} at the given index to the index
+// returned by the target function, which is called after the Pair{} is
+// removed, and emits a MoveEvent if its position changes.
+func (collection *Collection) movePair(index int, target func() int) {
+	pair := collection.removePair(index)
+	collection.insertPair(target(), pair)
+	if newIndex := pair.Index(); newIndex != index {
+		collection.emit(MoveEvent, pair.key, index, newIndex)
+	}
+}
+
+// The removePair() function removes the Pair{} at the given index of the
+// internal slice of Pair{}, and returns it.
+func (collection *Collection) removePair(index int) *Pair {
+	collection.copySnapshot()
+	pair := collection.pairs[index]
+	collection.pairs = append(collection.pairs[:index], collection.pairs[index+1:]...)
+	pair.detach()
+	pair.collection = nil
+	return pair
+}
+
 // Pair defines key-value pair of an element in Collection.
 type Pair struct {
-	key 	string
-	value 	interface{}
+	key 		interface{}
+	value 		interface{}
+	collection	*Collection
+}
+
+// The Index() function returns the index of the Pair{} in the insertion
+// order of the Collection it belongs to. If the Pair{} has been removed
+// from its Collection, or it was created by NewPair() function, it will
+// returns -1.
+func (pair *Pair) Index() int {
+	if pair.collection == nil {
+		return -1
+	}
+	for index, registered := range pair.collection.pairs {
+		if registered == pair {
+			return index
+		}
+	}
+	return -1
+}
+
+// The Key() function returns the key of the Pair{}.
+func (pair *Pair) Key() interface{} {
+	return pair.key
+}
+
+// The SetValue() function updates the value of the Pair{}. If the Pair{}
+// belongs to a Collection, the Collection will be updated as well, without
+// changing the order of insertion.
+func (pair *Pair) SetValue(value interface{}) *Pair {
+	if pair.collection == nil {
+		pair.value = value
+		return pair
+	}
+	oldValue := pair.value
+	pair.collection.copySnapshot()
+	pair.detach()
+	pair.value = value
+	pair.collection.attach(pair)
+	pair.collection.emit(UpdateEvent, pair.key, oldValue, value)
+	return pair
+}
+
+// The Value() function returns the value of the Pair{}.
+func (pair *Pair) Value() interface{} {
+	return pair.value
+}
+
+// The writeValue() function writes the value the same way as String()
+// function does. A Collection, and a slice or a map that does not implement
+// fmt.Stringer, are written element by element, so a circular element can
+// be found using the tracker.
+func writeValue(builder *strings.Builder, value interface{}, tracker *cycle.Tracker) {
+	reflection := reflect.ValueOf(value)
+	_, isCollection := value.(*Collection)
+	_, isStringer := value.(fmt.Stringer)
+	isNative := reflection.Kind() == reflect.Slice || reflection.Kind() == reflect.Map
+	if isCollection || (isNative && !isStringer) {
+		if !tracker.Enter(value) {
+			builder.WriteString(cycle.Marker)
+			return
+		}
+		defer tracker.Leave(value)
+	}
+	switch value := value.(type) {
+	case *Collection:
+		if value == nil {
+			builder.WriteString(fmt.Sprintf("%v", nil))
+			return
+		}
+		builder.WriteString("{")
+		for index, pair := range value.pairs {
+			if index != 0 {
+				builder.WriteString(",")
+			}
+			builder.WriteString(fmt.Sprintf("\"%s\":", KeyString(pair.key)))
+			writeValue(builder, pair.value, tracker)
+		}
+		builder.WriteString("}")
+		return
+	case json.Number:
+		builder.WriteString(value.String())
+		return
+	case fmt.Stringer:
+		builder.WriteString(fmt.Sprintf("%v", value))
+		return
+	}
+	switch reflection.Kind() {
+	case reflect.String:
+		builder.WriteString(fmt.Sprintf("\"%s\"", reflection.String()))
+	case reflect.Slice, reflect.Array:
+		builder.WriteString("[")
+		for index := 0; index < reflection.Len(); index++ {
+			if index != 0 {
+				builder.WriteString(",")
+			}
+			writeValue(builder, reflection.Index(index).Interface(), tracker)
+		}
+		builder.WriteString("]")
+	case reflect.Map:
+		keys := reflection.MapKeys()
+		sort.SliceStable(keys, func(i, j int) bool {
+			return lessKey(keys[i].Interface(), keys[j].Interface())
+		})
+		builder.WriteString("{")
+		for index, key := range keys {
+			if index != 0 {
+				builder.WriteString(",")
+			}
+			builder.WriteString(fmt.Sprintf("\"%s\":", KeyString(key.Interface())))
+			writeValue(builder, reflection.MapIndex(key).Interface(), tracker)
+		}
+		builder.WriteString("}")
+	default:
+		builder.WriteString(fmt.Sprintf("%v", value))
+	}
 }
 
-type ForEachFunc func (key string, value interface{})
\ No newline at end of file
+type ForEachFunc func (key interface{}, value interface{})
\ No newline at end of file
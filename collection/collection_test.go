@@ -1,7 +1,9 @@
 package collection
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
 	"reflect"
 	"testing"
 )
@@ -107,6 +109,68 @@ func TestNewFromMap(t *testing.T) {
 		t.Errorf("Expecting %s, got %s", testCollectionJsonStr, fmt.Sprint(collection))
 		return
 	}
+	// Test if the native key types are kept and sorted by value
+	collection, err = NewFromMap(map[int]string{10: "ten", 2: "two", -1: "minus one"})
+	if err != nil {
+		t.Error("NewFromMap(v) failed to create Collection from map")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	if !reflect.DeepEqual(collection.Keys(), []interface{}{-1, 2, 10}) {
+		t.Error("NewFromMap(v) Collection keys does not match map input")
+		t.Errorf("Expecting %v, got %v", []interface{}{-1, 2, 10}, collection.Keys())
+		return
+	}
+	if collection.Get(2) != "two" {
+		t.Error("NewFromMap(v) Collection value does not match map input")
+		t.Errorf("Expecting %v, got %v", "two", collection.Get(2))
+		return
+	}
+}
+
+func TestNewFromMap_circular(t *testing.T) {
+	circular := map[string]interface{}{"a": 1}
+	circular["self"] = circular
+	circular["child"] = map[string]interface{}{"parent": circular}
+	collection, err := NewFromMap(circular)
+	if err != nil {
+		t.Error("NewFromMap(v) failed to create Collection from a circular map")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	if collection.Get("self") != collection || collection.Get("child").(*Collection).Get("parent") != collection {
+		t.Error("NewFromMap(v) Collection does not keep the circular reference")
+		return
+	}
+}
+
+func TestNewFromPairs(t *testing.T) {
+	resetTestCollection()
+	collection := NewFromPairs(testCollection.Pairs()...)
+	if fmt.Sprint(collection) != testCollectionStr {
+		t.Error("NewFromPairs(pairs) Collection value does not match")
+		t.Errorf("Expecting %s, got %s", testCollectionStr, fmt.Sprint(collection))
+		return
+	}
+	if collection.PairOf("pkg") == testCollection.PairOf("pkg") {
+		t.Error("NewFromPairs(pairs) shares the Pair with the given Collection")
+		return
+	}
+	collection = NewFromPairs(NewPair("a", 1), nil, NewPair("b", 2), NewPair("a", 3))
+	if fmt.Sprint(collection) != "{\"a\":3,\"b\":2}" {
+		t.Error("NewFromPairs(pairs) with duplicated keys value does not match")
+		t.Errorf("Expecting %s, got %s", "{\"a\":3,\"b\":2}", fmt.Sprint(collection))
+		return
+	}
+}
+
+func TestNewPair(t *testing.T) {
+	pair := NewPair("a", 1)
+	if pair.Key() != "a" || pair.Value() != 1 || pair.Index() != -1 {
+		t.Error("NewPair(key, value) Pair does not match")
+		t.Errorf("Expecting %v, got %v", Pair{key: "a", value: 1}, *pair)
+		return
+	}
 }
 
 func TestCollection_Add(t *testing.T) {
@@ -165,6 +229,25 @@ func TestCollection_Add(t *testing.T) {
 	}
 }
 
+func TestCollection_At(t *testing.T) {
+	resetTestCollection()
+	collection := testCollection
+	tests := map[int]string{0: "pkg", 1: "detail", 4: "isPublic", -1: "isPublic", -5: "pkg"}
+	for index, key := range tests {
+		pair := collection.At(index)
+		if pair == nil || pair.key != key {
+			t.Errorf("collection.At(%d) Pair does not match", index)
+			t.Errorf("Expecting %v, got %v", key, pair)
+			return
+		}
+	}
+	if collection.At(5) != nil || collection.At(-6) != nil {
+		t.Error("collection.At(index) returns non-nil Pair on an out of range index")
+		t.Errorf("Expecting %v, got %v", nil, collection.At(5))
+		return
+	}
+}
+
 func TestCollection_Clear(t *testing.T) {
 	resetTestCollection()
 	collection := testCollection
@@ -198,12 +281,25 @@ func TestCollection_Delete(t *testing.T) {
 	}
 }
 
+func TestCollection_First(t *testing.T) {
+	resetTestCollection()
+	if pair := testCollection.First(); pair == nil || pair.key != "pkg" || pair.value != pkg {
+		t.Error("collection.First() Pair does not match")
+		t.Errorf("Expecting %v, got %v", NewPair("pkg", pkg), pair)
+		return
+	}
+	if New().First() != nil {
+		t.Error("collection.First() returns non-nil Pair on an empty Collection")
+		return
+	}
+}
+
 func TestCollection_ForEach(t *testing.T) {
 	resetTestCollection()
 	collection := testCollection
 	collectionStr := ""
-	collection.ForEach(func(key string, value interface{}) {
-		collectionStr = collectionStr + fmt.Sprintln(key + ":" + fmt.Sprint(value))
+	collection.ForEach(func(key interface{}, value interface{}) {
+		collectionStr = collectionStr + fmt.Sprintln(fmt.Sprint(key) + ":" + fmt.Sprint(value))
 	})
 	collectionStrShouldBe := fmt.Sprintf("pkg:%v\ndetail:%v\nversion:%v\nyear:%v\nisPublic:%v\n",
 		pkg,
@@ -298,10 +394,38 @@ func TestCollection_IndexOf(t *testing.T) {
 	}
 }
 
+func TestCollection_InsertAt(t *testing.T) {
+	collection := New().Set("a", 1).Set("b", 2).Set("c", 3)
+	tests := []struct {
+		index     int
+		key       string
+		expecting string
+	}{
+		{0, "x", "[x a b c]"},
+		{2, "y", "[x a y b c]"},
+		{-1, "z", "[x a y b z c]"},
+		{100, "a", "[x y b z c a]"},
+		{-100, "c", "[c x y b z a]"},
+	}
+	for _, test := range tests {
+		collection.InsertAt(test.index, test.key, test.index)
+		if fmt.Sprint(collection.Keys()) != test.expecting {
+			t.Errorf("collection.InsertAt(%d, %s, value) order does not match", test.index, test.key)
+			t.Errorf("Expecting %v, got %v", test.expecting, collection.Keys())
+			return
+		}
+	}
+	if collection.Get("a") != 100 {
+		t.Error("collection.InsertAt(index, key, value) value does not match")
+		t.Errorf("Expecting %v, got %v", 100, collection.Get("a"))
+		return
+	}
+}
+
 func TestCollection_Keys(t *testing.T) {
 	resetTestCollection()
 	collection := testCollection
-	keys := []string{"pkg", "detail", "version", "year", "isPublic"}
+	keys := []interface{}{"pkg", "detail", "version", "year", "isPublic"}
 	if len(collection.Keys()) != len(keys) {
 		t.Error("collection.Keys() length does not match")
 		t.Errorf("Expecting %v, got %v", len(keys), len(collection.Keys()))
@@ -319,6 +443,19 @@ func TestCollection_Keys(t *testing.T) {
 	}
 }
 
+func TestCollection_Last(t *testing.T) {
+	resetTestCollection()
+	if pair := testCollection.Last(); pair == nil || pair.key != "isPublic" || pair.value != true {
+		t.Error("collection.Last() Pair does not match")
+		t.Errorf("Expecting %v, got %v", NewPair("isPublic", true), pair)
+		return
+	}
+	if New().Last() != nil {
+		t.Error("collection.Last() returns non-nil Pair on an empty Collection")
+		return
+	}
+}
+
 func TestCollection_Length(t *testing.T) {
 	resetTestCollection()
 	collection := testCollection
@@ -329,11 +466,82 @@ func TestCollection_Length(t *testing.T) {
 	}
 }
 
+func TestCollection_MoveAfter(t *testing.T) {
+	collection := New().Set("a", 1).Set("b", 2).Set("c", 3)
+	collection.MoveAfter("a", "c")
+	if fmt.Sprint(collection.Keys()) != "[b c a]" {
+		t.Error("collection.MoveAfter(key, anchor) order does not match")
+		t.Errorf("Expecting %v, got %v", "[b c a]", collection.Keys())
+		return
+	}
+	collection.MoveAfter("a", "b").MoveAfter("a", "invalid")
+	if fmt.Sprint(collection) != "{\"b\":2,\"a\":1,\"c\":3}" {
+		t.Error("collection.MoveAfter(key, anchor) value does not match")
+		t.Errorf("Expecting %v, got %v", "{\"b\":2,\"a\":1,\"c\":3}", collection)
+		return
+	}
+}
+
+func TestCollection_MoveBefore(t *testing.T) {
+	collection := New().Set("a", 1).Set("b", 2).Set("c", 3)
+	collection.MoveBefore("c", "a")
+	if fmt.Sprint(collection.Keys()) != "[c a b]" {
+		t.Error("collection.MoveBefore(key, anchor) order does not match")
+		t.Errorf("Expecting %v, got %v", "[c a b]", collection.Keys())
+		return
+	}
+	collection.MoveBefore("c", "b").MoveBefore("invalid", "a")
+	if fmt.Sprint(collection.Keys()) != "[a c b]" {
+		t.Error("collection.MoveBefore(key, anchor) order does not match")
+		t.Errorf("Expecting %v, got %v", "[a c b]", collection.Keys())
+		return
+	}
+}
+
+func TestCollection_MoveToBack(t *testing.T) {
+	collection := New().Set("a", 1).Set("b", 2).Set("c", 3)
+	collection.MoveToBack("a").MoveToBack("invalid")
+	if fmt.Sprint(collection.Keys()) != "[b c a]" {
+		t.Error("collection.MoveToBack(key) order does not match")
+		t.Errorf("Expecting %v, got %v", "[b c a]", collection.Keys())
+		return
+	}
+}
+
+func TestCollection_MoveToFront(t *testing.T) {
+	collection := New().Set("a", 1).Set("b", 2).Set("c", 3)
+	collection.MoveToFront("c").MoveToFront("invalid")
+	if fmt.Sprint(collection.Keys()) != "[c a b]" {
+		t.Error("collection.MoveToFront(key) order does not match")
+		t.Errorf("Expecting %v, got %v", "[c a b]", collection.Keys())
+		return
+	}
+}
+
+func TestCollection_NextKey(t *testing.T) {
+	resetTestCollection()
+	collection := testCollection
+	if key, ok := collection.NextKey("pkg"); !ok || key != "detail" {
+		t.Error("collection.NextKey(key) key does not match")
+		t.Errorf("Expecting %v, got %v", "detail", key)
+		return
+	}
+	if key, ok := collection.NextKey("isPublic"); ok || key != nil {
+		t.Error("collection.NextKey(key) returns a key after the last key")
+		t.Errorf("Expecting %v, got %v", nil, key)
+		return
+	}
+	if _, ok := collection.NextKey("invalid"); ok {
+		t.Error("collection.NextKey(key) returns a key after a non-existent key")
+		return
+	}
+}
+
 func TestCollection_PairOf(t *testing.T) {
 	resetTestCollection()
 	collection := testCollection
-	pkgPair := Pair{"pkg", pkg}
-	versionPair := Pair{"version", version}
+	pkgPair := Pair{"pkg", pkg, collection}
+	versionPair := Pair{"version", version, collection}
 	if !reflect.DeepEqual(*collection.PairOf("pkg"), pkgPair) {
 		t.Error("collection.PairOf(key) Pair does not deeply equal")
 		t.Errorf("Expecting %v, got %v", true, false)
@@ -346,6 +554,62 @@ func TestCollection_PairOf(t *testing.T) {
 	}
 }
 
+func TestCollection_Pairs(t *testing.T) {
+	resetTestCollection()
+	collection := testCollection
+	pairs := collection.Pairs()
+	if len(pairs) != collection.Length() {
+		t.Error("collection.Pairs() length does not match")
+		t.Errorf("Expecting %v, got %v", collection.Length(), len(pairs))
+		return
+	}
+	for index, pair := range pairs {
+		if pair.Key() != collection.Keys()[index] || pair.Index() != index {
+			t.Error("collection.Pairs() does not follow the insertion order")
+			t.Errorf("Expecting %v, got %v", collection.Keys()[index], pair.Key())
+			return
+		}
+	}
+	pairs[0] = nil
+	if collection.First() == nil {
+		t.Error("collection.Pairs() shares the internal slice of Pair")
+		return
+	}
+}
+
+func TestCollection_Pop(t *testing.T) {
+	collection := New().Set("a", 1).Set("b", 2)
+	if pair := collection.Pop(); pair == nil || pair.key != "b" || pair.value != 2 {
+		t.Error("collection.Pop() Pair does not match")
+		t.Errorf("Expecting %v, got %v", NewPair("b", 2), pair)
+		return
+	}
+	collection.Pop()
+	if collection.Length() != 0 || collection.Pop() != nil {
+		t.Error("collection.Pop() does not remove the last element")
+		t.Errorf("Expecting %v, got %v", 0, collection.Length())
+		return
+	}
+}
+
+func TestCollection_PrevKey(t *testing.T) {
+	resetTestCollection()
+	collection := testCollection
+	if key, ok := collection.PrevKey("detail"); !ok || key != "pkg" {
+		t.Error("collection.PrevKey(key) key does not match")
+		t.Errorf("Expecting %v, got %v", "pkg", key)
+		return
+	}
+	if _, ok := collection.PrevKey("pkg"); ok {
+		t.Error("collection.PrevKey(key) returns a key before the first key")
+		return
+	}
+	if _, ok := collection.PrevKey("invalid"); ok {
+		t.Error("collection.PrevKey(key) returns a key before a non-existent key")
+		return
+	}
+}
+
 func TestCollection_Present(t *testing.T) {
 	resetTestCollection()
 	collection := testCollection
@@ -377,6 +641,22 @@ func TestCollection_Reflects(t *testing.T) {
 	}
 }
 
+func TestCollection_Rename(t *testing.T) {
+	collection := New().Set("a", 1).Set("b", 2).Set("c", 3)
+	collection.Rename("b", "x").Rename("invalid", "y")
+	if fmt.Sprint(collection) != "{\"a\":1,\"x\":2,\"c\":3}" {
+		t.Error("collection.Rename(oldKey, newKey) value does not match")
+		t.Errorf("Expecting %v, got %v", "{\"a\":1,\"x\":2,\"c\":3}", collection)
+		return
+	}
+	collection.Rename("c", "a")
+	if fmt.Sprint(collection) != "{\"x\":2,\"a\":3}" {
+		t.Error("collection.Rename(oldKey, newKey) does not replace the existing key")
+		t.Errorf("Expecting %v, got %v", "{\"x\":2,\"a\":3}", collection)
+		return
+	}
+}
+
 func TestCollection_Set(t *testing.T) {
 	child := New()
 	collection := New()
@@ -433,6 +713,139 @@ func TestCollection_Set(t *testing.T) {
 	}
 }
 
+func TestCollection_Set_nonStringKey(t *testing.T) {
+	type point struct {
+		x int
+		y int
+	}
+	pointer := &point{1, 2}
+	collection := New().
+		Set(1, "int").
+		Set("1", "string").
+		Set(point{1, 2}, "struct").
+		Set(pointer, "pointer").
+		Set(nil, "nil")
+	if collection.Length() != 5 {
+		t.Error("collection.Set() length does not match")
+		t.Errorf("Expecting %d, got %d", 5, collection.Length())
+		return
+	}
+	// Numbers of different types holding the same value are the same key
+	collection.Set(1.0, "float")
+	if collection.Length() != 5 || collection.Get(1) != "float" || collection.Get(int64(1)) != "float" {
+		t.Error("collection.Set() numeric key does not match")
+		t.Errorf("Expecting %v, got %v", "float", collection.Get(1))
+		return
+	}
+	if collection.Get("1") != "string" {
+		t.Error("collection.Set() string key does not match")
+		t.Errorf("Expecting %v, got %v", "string", collection.Get("1"))
+		return
+	}
+	if collection.Get(point{1, 2}) != "struct" || collection.Get(&point{1, 2}) != nil {
+		t.Error("collection.Set() struct key does not match")
+		t.Errorf("Expecting %v, got %v", "struct", collection.Get(point{1, 2}))
+		return
+	}
+	if collection.Get(pointer) != "pointer" || collection.Get(nil) != "nil" {
+		t.Error("collection.Set() pointer key does not match")
+		t.Errorf("Expecting %v, got %v", "pointer", collection.Get(pointer))
+		return
+	}
+	// NaN is the same key as NaN, and -0 is the same key as +0
+	collection.Set(math.NaN(), "first").Set(math.NaN(), "second")
+	collection.Set(0.0, "zero").Set(math.Copysign(0, -1), "negative zero")
+	if collection.Length() != 7 || collection.Get(math.NaN()) != "second" || collection.Get(0) != "negative zero" {
+		t.Error("collection.Set() NaN or zero key does not match")
+		t.Errorf("Expecting %d, got %d", 7, collection.Length())
+		return
+	}
+	// A float that holds a fraction is a different key
+	if collection.Has(1.5) {
+		t.Error("collection.Has() fractional key does not match")
+		t.Errorf("Expecting %v, got %v", false, true)
+		return
+	}
+}
+
+func TestCollection_Set_nonComparableKey(t *testing.T) {
+	defer func() {
+		if recovered := recover(); recovered != NonComparableKeyError {
+			t.Error("collection.Set() does not panic with NonComparableKeyError")
+			t.Errorf("Expecting %v, got %v", NonComparableKeyError, recovered)
+		}
+	}()
+	New().Set([]string{"a"}, "slice")
+}
+
+func TestCollection_Set_nonComparableNestedKey(t *testing.T) {
+	type wrapper struct {
+		value interface{}
+	}
+	collection := New().Set(wrapper{1}, "comparable")
+	if collection.Has(wrapper{[]string{"a"}}) {
+		t.Error("collection.Has() non-comparable key does not match")
+		t.Errorf("Expecting %v, got %v", false, true)
+		return
+	}
+	defer func() {
+		if recovered := recover(); recovered != NonComparableKeyError {
+			t.Error("collection.Set() does not panic with NonComparableKeyError")
+			t.Errorf("Expecting %v, got %v", NonComparableKeyError, recovered)
+		}
+	}()
+	collection.Set(wrapper{[]string{"a"}}, "slice")
+}
+
+func TestCollection_Shift(t *testing.T) {
+	collection := New().Set("a", 1).Set("b", 2)
+	if pair := collection.Shift(); pair == nil || pair.key != "a" || pair.value != 1 {
+		t.Error("collection.Shift() Pair does not match")
+		t.Errorf("Expecting %v, got %v", NewPair("a", 1), pair)
+		return
+	}
+	if fmt.Sprint(collection) != "{\"b\":2}" {
+		t.Error("collection.Shift() does not remove the first element")
+		t.Errorf("Expecting %v, got %v", "{\"b\":2}", collection)
+		return
+	}
+	collection.Shift()
+	if collection.Shift() != nil {
+		t.Error("collection.Shift() returns non-nil Pair on an empty Collection")
+		return
+	}
+}
+
+func TestCollection_Slice(t *testing.T) {
+	resetTestCollection()
+	collection := testCollection
+	tests := []struct {
+		start     int
+		end       int
+		expecting string
+	}{
+		{1, 3, "[detail version]"},
+		{-2, 5, "[year isPublic]"},
+		{-100, 2, "[pkg detail]"},
+		{3, 100, "[year isPublic]"},
+		{3, 1, "[]"},
+	}
+	for _, test := range tests {
+		sliced := collection.Slice(test.start, test.end)
+		if fmt.Sprint(sliced.Keys()) != test.expecting {
+			t.Errorf("collection.Slice(%d, %d) keys do not match", test.start, test.end)
+			t.Errorf("Expecting %v, got %v", test.expecting, sliced.Keys())
+			return
+		}
+	}
+	collection.Slice(0, 1).Set("pkg", "changed")
+	if collection.Get("pkg") != pkg {
+		t.Error("collection.Slice(start, end) shares the Pair with the Collection")
+		t.Errorf("Expecting %v, got %v", pkg, collection.Get("pkg"))
+		return
+	}
+}
+
 func TestCollection_String(t *testing.T) {
 	resetTestCollection()
 	collection := testCollection
@@ -441,6 +854,40 @@ func TestCollection_String(t *testing.T) {
 		t.Error("collection.String() does not return the expected string")
 		t.Errorf("Expecting %s, got %s", testCollectionStr, collectionStr)
 	}
+	collection = New().Set("number", json.Number("1.5"))
+	if fmt.Sprint(collection) != "{\"number\":1.5}" {
+		t.Error("collection.String() does not return the expected json.Number string")
+		t.Errorf("Expecting %s, got %s", "{\"number\":1.5}", fmt.Sprint(collection))
+	}
+}
+
+func TestCollection_String_circular(t *testing.T) {
+	collection := New().Set("a", 1)
+	collection.Set("self", collection).Set("list", []interface{}{collection, "b"})
+	expecting := "{\"a\":1,\"self\":[Circular],\"list\":[[Circular],\"b\"]}"
+	if collection.String() != expecting {
+		t.Error("collection.String() does not return the expected circular string")
+		t.Errorf("Expecting %s, got %s", expecting, collection.String())
+		return
+	}
+	shared := New().Set("b", 2)
+	collection = New().Set("x", shared).Set("y", shared)
+	expecting = "{\"x\":{\"b\":2},\"y\":{\"b\":2}}"
+	if collection.String() != expecting {
+		t.Error("collection.String() does not return the expected shared string")
+		t.Errorf("Expecting %s, got %s", expecting, collection.String())
+		return
+	}
+}
+
+func TestCollection_Swap(t *testing.T) {
+	collection := New().Set("a", 1).Set("b", 2).Set("c", 3)
+	collection.Swap("a", "c").Swap("a", "invalid")
+	if fmt.Sprint(collection) != "{\"c\":3,\"b\":2,\"a\":1}" {
+		t.Error("collection.Swap(a, b) value does not match")
+		t.Errorf("Expecting %v, got %v", "{\"c\":3,\"b\":2,\"a\":1}", collection)
+		return
+	}
 }
 
 func TestCollection_Values(t *testing.T) {
@@ -463,3 +910,61 @@ func TestCollection_Values(t *testing.T) {
 		return
 	}
 }
+
+func TestPair_Index(t *testing.T) {
+	collection := New().Set("a", 1).Set("b", 2).Set("c", 3)
+	pair := collection.PairOf("c")
+	if pair.Index() != 2 {
+		t.Error("pair.Index() index does not match")
+		t.Errorf("Expecting %v, got %v", 2, pair.Index())
+		return
+	}
+	collection.MoveToFront("c")
+	if pair.Index() != 0 {
+		t.Error("pair.Index() index does not follow the reordering")
+		t.Errorf("Expecting %v, got %v", 0, pair.Index())
+		return
+	}
+	collection.Delete("c")
+	if pair.Index() != -1 {
+		t.Error("pair.Index() returns an index after the Pair is removed")
+		t.Errorf("Expecting %v, got %v", -1, pair.Index())
+		return
+	}
+	first := collection.First()
+	collection.Clear()
+	if first.Index() != -1 {
+		t.Error("pair.Index() returns an index after the Collection is cleared")
+		t.Errorf("Expecting %v, got %v", -1, first.Index())
+		return
+	}
+}
+
+func TestPair_Key(t *testing.T) {
+	resetTestCollection()
+	if key := testCollection.At(1).Key(); key != "detail" {
+		t.Error("pair.Key() key does not match")
+		t.Errorf("Expecting %v, got %v", "detail", key)
+		return
+	}
+}
+
+func TestPair_SetValue(t *testing.T) {
+	resetTestCollection()
+	collection := testCollection
+	collection.PairOf("year").SetValue(2021).SetValue(2022)
+	if collection.Get("year") != 2022 || collection.IndexOf("year") != 3 {
+		t.Error("pair.SetValue(value) does not update the Collection")
+		t.Errorf("Expecting %v, got %v", 2022, collection.Get("year"))
+		return
+	}
+}
+
+func TestPair_Value(t *testing.T) {
+	resetTestCollection()
+	if value := testCollection.Last().Value(); value != true {
+		t.Error("pair.Value() value does not match")
+		t.Errorf("Expecting %v, got %v", true, value)
+		return
+	}
+}
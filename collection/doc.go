@@ -6,5 +6,12 @@
 /*
 The Collection holds key-value pairs and remembers the original insertion order
 of the keys. Any value may be used as either a key or a value.
+
+A key can be any value that can be compared using the == operator, the same
+way as a key of a native map, such as a string, a number, a struct or a
+pointer. Numbers are compared using the SameValueZero equality of the Map
+of JavaScript, so 1, int64(1) and 1.0 are the same key. Since a key is not
+always a string, the functions that take or return a key use interface{},
+and KeyString() function converts a key to its string form.
 */
 package collection
\ No newline at end of file
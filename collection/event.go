@@ -0,0 +1,281 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package collection
+
+// EventKind defines the kind of operation that changed a Collection.
+type EventKind int
+
+const (
+	// AddEvent is emitted when a new element is added. The OldValue is nil.
+	AddEvent EventKind = iota
+	// UpdateEvent is emitted when the value of an existing element changes.
+	UpdateEvent
+	// DeleteEvent is emitted when an element is removed. The NewValue is nil.
+	DeleteEvent
+	// ClearEvent is emitted when every element is removed by the Clear()
+	// function. The Key, OldValue and NewValue are nil.
+	ClearEvent
+	// MoveEvent is emitted when an element changes its position in the
+	// insertion order. The OldValue and NewValue are the old and new index.
+	MoveEvent
+	// RenameEvent is emitted when an element changes its key. The Key is the
+	// new key, and the OldValue and NewValue are the old and new key.
+	RenameEvent
+)
+
+// The String() function returns the name of the EventKind.
+func (kind EventKind) String() string {
+	switch kind {
+	case AddEvent:
+		return "add"
+	case UpdateEvent:
+		return "update"
+	case DeleteEvent:
+		return "delete"
+	case ClearEvent:
+		return "clear"
+	case MoveEvent:
+		return "move"
+	case RenameEvent:
+		return "rename"
+	}
+	return "unknown"
+}
+
+// Event describes a single change of a Collection, or of a Collection
+// nested inside it.
+type Event struct {
+	// Kind is the kind of operation.
+	Kind EventKind
+	// Key is the key of the changed element, inside the Collection that
+	// actually changed.
+	Key interface{}
+	// Path is the list of keys from the observed Collection down to the
+	// changed element. For a change of the observed Collection itself, it
+	// only holds the Key. For a ClearEvent, it does not hold the Key.
+	Path []interface{}
+	// OldValue is the value before the change.
+	OldValue interface{}
+	// NewValue is the value after the change.
+	NewValue interface{}
+}
+
+// The Batch() function calls the given function, and delivers every event
+// emitted by the Collection while the function runs to the handlers in a
+// single call once it returns, instead of a call for each event. Batches
+// can be nested, in which case the events are delivered once the outermost
+// batch returns.
+func (collection *Collection) Batch(function func()) *Collection {
+	observation := collection.observe()
+	observation.batching++
+	defer func() {
+		observation.batching--
+		if observation.batching == 0 && len(observation.pending) > 0 {
+			events := observation.pending
+			observation.pending = nil
+			collection.deliver(events)
+		}
+	}()
+	function()
+	return collection
+}
+
+// The OnChange() function registers a handler that is called with the
+// events of every change made to the Collection through its functions,
+// including the changes made to the Collections nested inside it at any
+// depth, and returns a function that unregisters the handler. Outside of
+// Batch() function, the handler is called once for each event. Once the
+// Collection is no longer observed, either directly or through a Collection
+// that holds it, the nested Collections stop reporting their changes to it.
+//
+// A nested Collection reports its changes to every Collection that holds
+// it while being observed. The changes made to a value that is not a
+// Collection, such as an Array, cannot be observed.
+func (collection *Collection) OnChange(handler ChangeFunc) func() {
+	watched := collection.isWatched()
+	observation := collection.observe()
+	registered := &handler
+	observation.handlers = append(observation.handlers, registered)
+	if !watched {
+		collection.linkChildren()
+	}
+	return func() {
+		for index, candidate := range observation.handlers {
+			if candidate == registered {
+				observation.handlers = append(observation.handlers[:index:index], observation.handlers[index+1:]...)
+				if !collection.isWatched() {
+					collection.unlinkChildren()
+				}
+				return
+			}
+		}
+	}
+}
+
+// observation holds the observing state of a Collection. It is only
+// allocated once a Collection is observed, either directly or through a
+// Collection that holds it.
+type observation struct {
+	handlers []*ChangeFunc
+	batching int
+	pending  []Event
+	// parents holds each Pair{} of an observed Collection whose value is
+	// this Collection.
+	parents []*Pair
+}
+
+func (collection *Collection) observe() *observation {
+	if collection.observation == nil {
+		collection.observation = &observation{}
+	}
+	return collection.observation
+}
+
+// The emit() function reports a change of the Collection to its handlers,
+// and to the handlers of every Collection that holds it.
+func (collection *Collection) emit(kind EventKind, key interface{}, oldValue interface{}, newValue interface{}) {
+	if collection.observation == nil {
+		return
+	}
+	event := Event{Kind: kind, Key: key, OldValue: oldValue, NewValue: newValue}
+	if kind != ClearEvent {
+		event.Path = []interface{}{key}
+	}
+	collection.notify(event, nil)
+}
+
+func (collection *Collection) notify(event Event, visited map[*Collection]bool) {
+	observation := collection.observation
+	if observation == nil {
+		return
+	}
+	if observation.batching > 0 {
+		observation.pending = append(observation.pending, event)
+	} else if len(observation.handlers) > 0 {
+		collection.deliver([]Event{event})
+	}
+	parents := observation.parents
+	if len(parents) == 0 {
+		return
+	}
+	if visited == nil {
+		visited = make(map[*Collection]bool)
+	}
+	visited[collection] = true
+	for _, parent := range parents {
+		if !parent.holds(collection) || visited[parent.collection] {
+			continue
+		}
+		deep := event
+		deep.Path = append([]interface{}{parent.key}, event.Path...)
+		parent.collection.notify(deep, visited)
+	}
+}
+
+func (collection *Collection) deliver(events []Event) {
+	handlers := make([]*ChangeFunc, len(collection.observation.handlers))
+	copy(handlers, collection.observation.handlers)
+	for _, handler := range handlers {
+		(*handler)(events)
+	}
+}
+
+// The isWatched() function determines whether a change of the Collection
+// has to be reported to any handler, either its own or the handler of a
+// Collection that holds it.
+func (collection *Collection) isWatched() bool {
+	return collection.isWatchedFrom(make(map[*Collection]bool))
+}
+
+func (collection *Collection) isWatchedFrom(visited map[*Collection]bool) bool {
+	observation := collection.observation
+	if observation == nil || visited[collection] {
+		return false
+	}
+	if len(observation.handlers) > 0 {
+		return true
+	}
+	visited[collection] = true
+	for _, parent := range observation.parents {
+		if parent.holds(collection) && parent.collection.isWatchedFrom(visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// The attach() function links the value of the given Pair{} to the
+// Collection if the value is a Collection and the Collection is observed,
+// so the value reports its changes to the Collection.
+func (collection *Collection) attach(pair *Pair) {
+	child, ok := pair.value.(*Collection)
+	if !ok || child == nil || !collection.isWatched() {
+		return
+	}
+	if child.addParent(pair) {
+		child.linkChildren()
+	}
+}
+
+// The linkChildren() function links every Collection value of the
+// Collection, at any depth, to the Pair{} that holds it.
+func (collection *Collection) linkChildren() {
+	for _, pair := range collection.pairs {
+		if child, ok := pair.value.(*Collection); ok && child != nil && child.addParent(pair) {
+			child.linkChildren()
+		}
+	}
+}
+
+// The unlinkChildren() function removes the links created by
+// linkChildren() function, at any depth, except below a Collection that is
+// still watched.
+func (collection *Collection) unlinkChildren() {
+	for _, pair := range collection.pairs {
+		child, ok := pair.value.(*Collection)
+		if ok && pair.detach() && !child.isWatched() {
+			child.unlinkChildren()
+		}
+	}
+}
+
+func (collection *Collection) addParent(pair *Pair) bool {
+	observation := collection.observe()
+	for _, parent := range observation.parents {
+		if parent == pair {
+			return false
+		}
+	}
+	observation.parents = append(observation.parents, pair)
+	return true
+}
+
+// The detach() function removes the link created by attach() function
+// between the value of the Pair{} and the Collection that holds it. It
+// returns false if there was no such link.
+func (pair *Pair) detach() bool {
+	child, ok := pair.value.(*Collection)
+	if !ok || child == nil || child.observation == nil {
+		return false
+	}
+	parents := child.observation.parents
+	for index, parent := range parents {
+		if parent == pair {
+			child.observation.parents = append(parents[:index:index], parents[index+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// The holds() function determines whether the Pair{} still belongs to a
+// Collection and holds the given Collection as its value.
+func (pair *Pair) holds(child *Collection) bool {
+	value, ok := pair.value.(*Collection)
+	return pair.collection != nil && ok && value == child
+}
+
+type ChangeFunc func (events []Event)
@@ -0,0 +1,158 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package collection
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCollection_Batch(t *testing.T) {
+	collection := New()
+	var calls [][]Event
+	collection.OnChange(func(events []Event) {
+		calls = append(calls, events)
+	})
+	collection.Batch(func() {
+		collection.Set("a", 1)
+		collection.Batch(func() {
+			collection.Set("b", 2)
+		})
+		collection.Delete("a")
+	})
+	if len(calls) != 1 || len(calls[0]) != 3 {
+		t.Error("collection.Batch() does not deliver the events in a single call")
+		t.Errorf("Expecting %v, got %v", 1, len(calls))
+		return
+	}
+	if calls[0][2].Kind != DeleteEvent || calls[0][2].OldValue != 1 {
+		t.Error("collection.Batch() event does not match")
+		t.Errorf("Expecting %v, got %v", DeleteEvent, calls[0][2].Kind)
+		return
+	}
+}
+
+func TestCollection_OnChange(t *testing.T) {
+	collection := New().Set("a", 1).Set("b", 2)
+	var events []Event
+	unsubscribe := collection.OnChange(func(delivered []Event) {
+		events = append(events, delivered...)
+	})
+	collection.Set("a", 10).Set("c", 3).Delete("b").MoveToFront("c").Rename("a", "z")
+	collection.PairOf("c").SetValue(30)
+	collection.Clear()
+	expecting := []Event{
+		{UpdateEvent, "a", []interface{}{"a"}, 1, 10},
+		{AddEvent, "c", []interface{}{"c"}, nil, 3},
+		{DeleteEvent, "b", []interface{}{"b"}, 2, nil},
+		{MoveEvent, "c", []interface{}{"c"}, 1, 0},
+		{RenameEvent, "z", []interface{}{"z"}, "a", "z"},
+		{UpdateEvent, "c", []interface{}{"c"}, 3, 30},
+		{ClearEvent, nil, nil, nil, nil},
+	}
+	if !reflect.DeepEqual(events, expecting) {
+		t.Error("collection.OnChange() events does not match")
+		t.Errorf("Expecting %v, got %v", expecting, events)
+		return
+	}
+	unsubscribe()
+	unsubscribe()
+	collection.Set("d", 4)
+	if len(events) != len(expecting) {
+		t.Error("collection.OnChange() handler is called after unsubscribing")
+		t.Errorf("Expecting %v, got %v", len(expecting), len(events))
+		return
+	}
+}
+
+func TestCollection_OnChange_deep(t *testing.T) {
+	grandchild := New()
+	child := New().Set("grandchild", grandchild)
+	collection := New().Set("child", child)
+	var events []Event
+	collection.OnChange(func(delivered []Event) {
+		events = append(events, delivered...)
+	})
+	grandchild.Set("a", 1)
+	added := New()
+	child.Set("added", added)
+	added.Set("b", 2)
+	expecting := []Event{
+		{AddEvent, "a", []interface{}{"child", "grandchild", "a"}, nil, 1},
+		{AddEvent, "added", []interface{}{"child", "added"}, nil, added},
+		{AddEvent, "b", []interface{}{"child", "added", "b"}, nil, 2},
+	}
+	if !reflect.DeepEqual(events, expecting) {
+		t.Error("collection.OnChange() deep events does not match")
+		t.Errorf("Expecting %v, got %v", expecting, events)
+		return
+	}
+	// A removed Collection does not report its changes anymore
+	collection.Delete("child")
+	events = nil
+	grandchild.Set("c", 3)
+	if len(events) != 0 {
+		t.Error("collection.OnChange() reports the changes of a removed Collection")
+		t.Errorf("Expecting %v, got %v", 0, len(events))
+		return
+	}
+}
+
+func TestCollection_OnChange_cycle(t *testing.T) {
+	collection := New()
+	child := New().Set("parent", collection)
+	collection.Set("child", child)
+	var events []Event
+	collection.OnChange(func(delivered []Event) {
+		events = append(events, delivered...)
+	})
+	child.Set("a", 1)
+	if len(events) != 1 || !reflect.DeepEqual(events[0].Path, []interface{}{"child", "a"}) {
+		t.Error("collection.OnChange() does not report a change inside a cycle once")
+		t.Errorf("Expecting %v, got %v", 1, len(events))
+		return
+	}
+}
+
+func TestCollection_OnChange_unsubscribe(t *testing.T) {
+	grandchild := New()
+	child := New().Set("grandchild", grandchild)
+	collection := New().Set("child", child)
+	var events []Event
+	unsubscribe := collection.OnChange(func(delivered []Event) {
+		events = append(events, delivered...)
+	})
+	var childEvents []Event
+	unsubscribeChild := child.OnChange(func(delivered []Event) {
+		childEvents = append(childEvents, delivered...)
+	})
+	unsubscribe()
+	if len(child.observation.parents) != 0 || len(grandchild.observation.parents) != 1 {
+		t.Error("collection.OnChange() unsubscribing does not unlink the children")
+		t.Errorf("Expecting %v, got %v", 0, len(child.observation.parents))
+		return
+	}
+	grandchild.Set("a", 1)
+	if len(events) != 0 || len(childEvents) != 1 {
+		t.Error("collection.OnChange() reports to an unsubscribed parent")
+		t.Errorf("Expecting %v, got %v", 0, len(events))
+		return
+	}
+	unsubscribeChild()
+	if len(grandchild.observation.parents) != 0 {
+		t.Error("collection.OnChange() unsubscribing does not unlink the children")
+		t.Errorf("Expecting %v, got %v", 0, len(grandchild.observation.parents))
+		return
+	}
+	// A cycle is unlinked as well
+	child.Set("parent", collection)
+	collection.OnChange(func(delivered []Event) {})()
+	if len(child.observation.parents) != 0 || len(collection.observation.parents) != 0 {
+		t.Error("collection.OnChange() unsubscribing does not unlink a cycle")
+		t.Errorf("Expecting %v, got %v", 0, len(collection.observation.parents))
+		return
+	}
+}
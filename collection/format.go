@@ -0,0 +1,38 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package collection
+
+import (
+	"fmt"
+
+	"github.com/with-go/standard/pretty"
+)
+
+// The Format() function implements fmt.Formatter. The %v verb prints the
+// same compact string as String() function, the %+v verb prints indented
+// multi-line output using the "pretty" package, and the %#v verb prints the
+// Go source that rebuilds the Collection. The verbs that do not accept a
+// string, such as %d, format the values of the Collection.
+func (collection *Collection) Format(state fmt.State, verb rune) {
+	if collection == nil {
+		if verb == 'v' && state.Flag('#') {
+			_, _ = fmt.Fprint(state, collection.GoString())
+		} else {
+			_, _ = fmt.Fprint(state, nil)
+		}
+		return
+	}
+	pretty.Format(state, verb, collection, collection.Values())
+}
+
+// The GoString() function returns the Go source that rebuilds the
+// Collection in the same order, such as collection.New().Set("a", 1).
+func (collection *Collection) GoString() string {
+	if collection == nil {
+		return "(*collection.Collection)(nil)"
+	}
+	return pretty.GoString(collection)
+}
@@ -0,0 +1,48 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package collection
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/with-go/standard/array"
+)
+
+func TestCollection_Format(t *testing.T) {
+	collection := New().Set("b", array.New(1)).Set(2, New().Set("c", nil))
+	tests := []struct {
+		format   string
+		expected string
+	}{
+		{"%v", "{\"b\":[1],\"2\":{\"c\":<nil>}}"},
+		{"%+v", "{\n  \"b\": [\n    1\n  ],\n  \"2\": {\n    \"c\": null\n  }\n}"},
+		{"%#v", "collection.New().Set(\"b\", array.Array{1}).Set(2, collection.New().Set(\"c\", nil))"},
+	}
+	for _, test := range tests {
+		if result := fmt.Sprintf(test.format, collection); result != test.expected {
+			t.Error("collection.Format() value does not match")
+			t.Errorf("Expecting %q, got %q", test.expected, result)
+			return
+		}
+	}
+	var empty *Collection
+	if result := fmt.Sprintf("%v %#v", empty, empty); result != "<nil> (*collection.Collection)(nil)" {
+		t.Error("collection.Format() nil value does not match")
+		t.Errorf("Expecting %q, got %q", "<nil> (*collection.Collection)(nil)", result)
+		return
+	}
+}
+
+func TestCollection_GoString(t *testing.T) {
+	collection := New().Set(int64(1), "a")
+	expected := "collection.New().Set(int64(1), \"a\")"
+	if collection.GoString() != expected {
+		t.Error("collection.GoString() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, collection.GoString())
+		return
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package collection
+
+import (
+	"bytes"
+	"encoding/gob"
+
+	"github.com/with-go/standard/cycle"
+)
+
+func init() {
+	// Registering the types allows them to be sent as interface{} values,
+	// which is how nested elements are encoded.
+	gob.Register(New())
+	gob.Register([]interface{}{})
+	gob.Register(map[string]interface{}{})
+}
+
+// The GobEncode() function implements the gob.GobEncoder interface. The
+// keys are encoded in insertion order, and each value is encoded as an
+// interface{} value, so nested Arrays, Objects, Collections and common
+// scalar types keep their type when decoded. Any other value type has to
+// be registered using gob.Register(). A circular reference cannot be
+// encoded, so it will returns a cycle.PathError.
+func (collection *Collection) GobEncode() ([]byte, error) {
+	if err := cycle.Check(collection); err != nil {
+		return nil, err
+	}
+	buffer := &bytes.Buffer{}
+	encoder := gob.NewEncoder(buffer)
+	if err := encoder.Encode(collection.Keys()); err != nil {
+		return nil, err
+	}
+	if err := encoder.Encode(collection.Values()); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// The GobDecode() function implements the gob.GobDecoder interface. It
+// replaces the elements of the Collection with the decoded elements, in
+// the same insertion order as they were encoded.
+func (collection *Collection) GobDecode(data []byte) error {
+	var keys []interface{}
+	var values []interface{}
+	decoder := gob.NewDecoder(bytes.NewReader(data))
+	if err := decoder.Decode(&keys); err != nil {
+		return err
+	}
+	if err := decoder.Decode(&values); err != nil {
+		return err
+	}
+	if len(keys) != len(values) {
+		return CorruptDataError
+	}
+	collection.Clear()
+	for index, key := range keys {
+		collection.insertPair(len(collection.pairs), &Pair{key: key, value: values[index]})
+		collection.emit(AddEvent, key, nil, values[index])
+	}
+	return nil
+}
+
+// The MarshalBinary() function implements the encoding.BinaryMarshaler
+// interface. It returns the same data as the GobEncode() function.
+func (collection *Collection) MarshalBinary() ([]byte, error) {
+	return collection.GobEncode()
+}
+
+// The UnmarshalBinary() function implements the encoding.BinaryUnmarshaler
+// interface. It accepts the data returned by the MarshalBinary() function.
+func (collection *Collection) UnmarshalBinary(data []byte) error {
+	return collection.GobDecode(data)
+}
@@ -0,0 +1,85 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package collection
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/with-go/standard/array"
+	"github.com/with-go/standard/cycle"
+	"github.com/with-go/standard/object"
+)
+
+func TestCollection_GobEncode(t *testing.T) {
+	collection := New().
+		Set("z", 1).
+		Set("a", int64(2)).
+		Set("m", nil).
+		Set("child", New().Set("y", "b").Set("x", array.New(1.5, New()))).
+		Set("object", object.New().Set("c", true))
+	buffer := &bytes.Buffer{}
+	if err := gob.NewEncoder(buffer).Encode(collection); err != nil {
+		t.Error("gob.Encoder.Encode(collection) failed to encode")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	decoded := New()
+	if err := gob.NewDecoder(buffer).Decode(decoded); err != nil {
+		t.Error("gob.Decoder.Decode(collection) failed to decode")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	if !reflect.DeepEqual(decoded, collection) {
+		t.Error("collection.GobEncode() does not round-trip")
+		t.Errorf("Expecting %v, got %v", collection, decoded)
+		return
+	}
+	if !reflect.DeepEqual(decoded.Keys(), []interface{}{"z", "a", "m", "child", "object"}) {
+		t.Error("collection.GobDecode(data) does not keep the key order")
+		t.Errorf("Expecting %v, got %v", collection.Keys(), decoded.Keys())
+		return
+	}
+}
+
+func TestCollection_GobEncode_circular(t *testing.T) {
+	collection := New()
+	collection.Set("child", array.New(collection))
+	_, err := collection.GobEncode()
+	if !errors.Is(err, cycle.CircularReferenceError) || err.Error() != "the given value contains a circular reference at $.child[0]" {
+		t.Error("collection.GobEncode() error does not match")
+		t.Errorf("Expecting %v, got %v", cycle.CircularReferenceError, err)
+		return
+	}
+}
+
+func TestCollection_MarshalBinary(t *testing.T) {
+	collection := New().Set("b", "c").Set("a", 1)
+	data, err := collection.MarshalBinary()
+	if err != nil {
+		t.Error("collection.MarshalBinary() failed to encode")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	decoded := New().Set("stale", true)
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Error("collection.UnmarshalBinary(data) failed to decode")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	if !reflect.DeepEqual(decoded, collection) {
+		t.Error("collection.UnmarshalBinary(data) does not round-trip")
+		t.Errorf("Expecting %v, got %v", collection, decoded)
+		return
+	}
+	if err := decoded.UnmarshalBinary([]byte("invalid")); err == nil {
+		t.Error("collection.UnmarshalBinary(data) accepted invalid data")
+		return
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package collection
+
+import (
+	"encoding"
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/with-go/standard/internal/comparison"
+)
+
+// The KeyString() function returns the string form of a key, which is used
+// whenever a key has to be written as a string, such as by String() function
+// or Presenter.AsMap() function. A string key is returned as it is, a key
+// that implements encoding.TextMarshaler is returned as its text, and any
+// other key is formatted using the "fmt" package.
+func KeyString(key interface{}) string {
+	switch key := key.(type) {
+	case string:
+		return key
+	case encoding.TextMarshaler:
+		if text, err := key.MarshalText(); err == nil {
+			return string(text)
+		}
+	}
+	return fmt.Sprint(key)
+}
+
+// The keysEqual() function determines whether two keys are the same key,
+// using the SameValueZero equality of JavaScript for numbers: numbers of
+// different types are equal if they hold the same value, NaN is equal to
+// NaN, and +0 is equal to -0. Any other keys are compared using ==, and a
+// key that cannot be compared is not equal to any key.
+func keysEqual(a interface{}, b interface{}) bool {
+	if stringOfA, ok := a.(string); ok {
+		stringOfB, ok := b.(string)
+		return ok && stringOfA == stringOfB
+	}
+	numberOfA, isNumberA := numberOf(a)
+	numberOfB, isNumberB := numberOf(b)
+	if isNumberA || isNumberB {
+		return isNumberA && isNumberB && numberOfA.equal(numberOfB)
+	}
+	return comparison.IsComparable(a) && comparison.IsComparable(b) && a == b
+}
+
+// The checkKey() function panics with NonComparableKeyError if the given
+// key cannot be compared using ==, the same way as a native map does. A
+// struct or an array whose interface elements hold a slice, a map or a
+// function cannot be compared either, although its type is comparable.
+func checkKey(key interface{}) {
+	if !comparison.IsComparable(key) {
+		panic(NonComparableKeyError)
+	}
+}
+
+// The lessKey() function defines the order of keys used by NewFromMap()
+// function. Numbers are sorted by value, and any other keys are sorted
+// alphabetically by their KeyString() form.
+func lessKey(a interface{}, b interface{}) bool {
+	numberOfA, isNumberA := numberOf(a)
+	numberOfB, isNumberB := numberOf(b)
+	if isNumberA && isNumberB {
+		return numberOfA.less(numberOfB)
+	}
+	return KeyString(a) < KeyString(b)
+}
+
+// number holds a numeric key in the widest type of its kind.
+type number struct {
+	kind     reflect.Kind
+	signed   int64
+	unsigned uint64
+	float    float64
+}
+
+func numberOf(v interface{}) (number, bool) {
+	reflection := reflect.ValueOf(v)
+	switch reflection.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return number{kind: reflect.Int64, signed: reflection.Int()}, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return number{kind: reflect.Uint64, unsigned: reflection.Uint()}, true
+	case reflect.Float32, reflect.Float64:
+		return number{kind: reflect.Float64, float: reflection.Float()}, true
+	}
+	return number{}, false
+}
+
+func (a number) equal(b number) bool {
+	if a.kind == reflect.Float64 && b.kind == reflect.Float64 {
+		return a.float == b.float || math.IsNaN(a.float) && math.IsNaN(b.float)
+	}
+	if b.kind == reflect.Float64 {
+		a, b = b, a
+	}
+	switch {
+	case a.kind == reflect.Float64:
+		// An integer only equals a float if the float holds an integral
+		// value that the integer type can hold exactly.
+		if math.Trunc(a.float) != a.float {
+			return false
+		}
+		if b.kind == reflect.Int64 {
+			return a.float >= math.MinInt64 && a.float < math.MaxInt64 && int64(a.float) == b.signed
+		}
+		return a.float >= 0 && a.float < math.MaxUint64 && uint64(a.float) == b.unsigned
+	case a.kind == reflect.Int64 && b.kind == reflect.Int64:
+		return a.signed == b.signed
+	case a.kind == reflect.Uint64 && b.kind == reflect.Uint64:
+		return a.unsigned == b.unsigned
+	case a.kind == reflect.Int64:
+		return a.signed >= 0 && uint64(a.signed) == b.unsigned
+	}
+	return b.signed >= 0 && uint64(b.signed) == a.unsigned
+}
+
+func (a number) less(b number) bool {
+	return a.value() < b.value()
+}
+
+func (a number) value() float64 {
+	switch a.kind {
+	case reflect.Int64:
+		return float64(a.signed)
+	case reflect.Uint64:
+		return float64(a.unsigned)
+	}
+	return a.float
+}
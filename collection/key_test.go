@@ -0,0 +1,32 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package collection
+
+import (
+	"net"
+	"testing"
+)
+
+func TestKeyString(t *testing.T) {
+	tests := []struct {
+		key       interface{}
+		expecting string
+	}{
+		{"name", "name"},
+		{42, "42"},
+		{1.5, "1.5"},
+		{true, "true"},
+		{nil, "<nil>"},
+		{net.IPv4(127, 0, 0, 1), "127.0.0.1"},
+	}
+	for _, test := range tests {
+		if KeyString(test.key) != test.expecting {
+			t.Error("KeyString() value does not match")
+			t.Errorf("Expecting %v, got %v", test.expecting, KeyString(test.key))
+			return
+		}
+	}
+}
@@ -5,10 +5,6 @@
 
 package collection
 
-import (
-	"reflect"
-)
-
 type Presenter struct {
 	collection *Collection
 }
@@ -17,14 +13,25 @@ type Presenter struct {
 // interface{} based on the elements inside the Collection. As a map will not
 // remember the insertion order (as how a Collection does remember the insertion
 // order) the element order of the returned Object will not predictable and may
-// not be the same as the Collection.
+// not be the same as the Collection. Each key is converted using KeyString()
+// function. A Collection that appears more than once, including a Collection
+// that holds itself, is converted into a single map, so the returned map
+// holds the same circular references instead of recursing forever.
 func (presenter Presenter) AsMap() map[string]interface{} {
+	return asMap(presenter.collection, make(map[*Collection]map[string]interface{}))
+}
+
+func asMap(collection *Collection, converted map[*Collection]map[string]interface{}) map[string]interface{} {
 	object := make(map[string]interface{})
-	for _, pair := range presenter.collection.pairs {
-		object[pair.key] = pair.value
-		if t := reflect.TypeOf(pair.value); t.Kind() == reflect.Ptr && t.Elem().Name() == "Collection" {
-			element := pair.value.(*Collection)
-			object[pair.key] = element.Present().AsMap()
+	converted[collection] = object
+	for _, pair := range collection.pairs {
+		object[KeyString(pair.key)] = pair.value
+		if element, ok := pair.value.(*Collection); ok && element != nil {
+			if existing, ok := converted[element]; ok {
+				object[KeyString(pair.key)] = existing
+			} else {
+				object[KeyString(pair.key)] = asMap(element, converted)
+			}
 		}
 	}
 	return object
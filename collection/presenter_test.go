@@ -2,6 +2,7 @@ package collection
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 )
 
@@ -33,3 +34,14 @@ func TestPresenter_AsMap(t *testing.T) {
 		return
 	}
 }
+
+func TestPresenter_AsMap_circular(t *testing.T) {
+	collection := New().Set("a", 1)
+	collection.Set("child", New().Set("parent", collection))
+	object := collection.Present().AsMap()
+	child := object["child"].(map[string]interface{})
+	if reflect.ValueOf(child["parent"]).Pointer() != reflect.ValueOf(object).Pointer() {
+		t.Error("collection.Present().AsMap() does not keep the circular reference")
+		return
+	}
+}
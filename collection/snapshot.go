@@ -0,0 +1,84 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package collection
+
+// The Restore() function replaces the elements of the Collection with the
+// elements saved in the given Snapshot, in the exact insertion order they
+// had when the Snapshot was taken. If the Collection is observed, the
+// handlers receive a ClearEvent followed by an AddEvent for each element,
+// in a single call.
+func (collection *Collection) Restore(snapshot *Snapshot) *Collection {
+	snapshot.copy()
+	restore := func() {
+		collection.Clear()
+		for index, key := range snapshot.keys {
+			collection.insertPair(len(collection.pairs), &Pair{ key: key, value: snapshot.values[index] })
+			collection.emit(AddEvent, key, nil, snapshot.values[index])
+		}
+	}
+	if collection.observation != nil {
+		collection.Batch(restore)
+	} else {
+		restore()
+	}
+	return collection
+}
+
+// The Snapshot() function saves the current keys and values of the
+// Collection, in insertion order, so they can be restored later by the
+// Restore() function. Only the key-value pairs are saved: a value is not
+// copied, so a change made inside a nested Collection is not undone by
+// restoring the Snapshot.
+//
+// Taking a Snapshot costs O(1): the Snapshot shares the elements of the
+// Collection, and the keys and values are only copied by the first change
+// of the Collection made afterwards.
+func (collection *Collection) Snapshot() *Snapshot {
+	if collection.snapshot == nil {
+		collection.snapshot = &Snapshot{collection: collection}
+	}
+	return collection.snapshot
+}
+
+// Snapshot defines the saved state of a Collection. See Snapshot() function.
+type Snapshot struct {
+	// collection is the Collection whose elements are shared by the
+	// Snapshot, until the Collection changes. It is nil once the keys and
+	// values have been copied.
+	collection *Collection
+	keys       []interface{}
+	values     []interface{}
+}
+
+// The Length() function returns the number of elements saved in the
+// Snapshot.
+func (snapshot *Snapshot) Length() int {
+	if snapshot.collection != nil {
+		return len(snapshot.collection.pairs)
+	}
+	return len(snapshot.keys)
+}
+
+// The copy() function copies the keys and values shared with the
+// Collection, so the Collection can change without changing the Snapshot.
+func (snapshot *Snapshot) copy() {
+	if snapshot.collection == nil {
+		return
+	}
+	snapshot.keys = snapshot.collection.Keys()
+	snapshot.values = snapshot.collection.Values()
+	snapshot.collection.snapshot = nil
+	snapshot.collection = nil
+}
+
+// The copySnapshot() function copies the keys and values of the Snapshot
+// that still shares the elements of the Collection. It has to be called
+// before any change of the insertion order, or of a key or a value.
+func (collection *Collection) copySnapshot() {
+	if collection.snapshot != nil {
+		collection.snapshot.copy()
+	}
+}
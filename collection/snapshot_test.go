@@ -0,0 +1,78 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package collection
+
+import (
+	"testing"
+)
+
+func TestCollection_Restore(t *testing.T) {
+	collection := New().Set("b", 2).Set("a", 1).Set("c", 3)
+	snapshot := collection.Snapshot()
+	pair := collection.PairOf("a")
+	collection.Delete("b").Set("a", 10).MoveToFront("c").Set("d", 4)
+	var events []Event
+	collection.OnChange(func(delivered []Event) {
+		events = append(events, delivered...)
+	})
+	collection.Restore(snapshot)
+	expecting := "{\"b\":2,\"a\":1,\"c\":3}"
+	if collection.String() != expecting {
+		t.Error("collection.Restore() value does not match")
+		t.Errorf("Expecting %v, got %v", expecting, collection)
+		return
+	}
+	if len(events) != 4 || events[0].Kind != ClearEvent {
+		t.Error("collection.Restore() events does not match")
+		t.Errorf("Expecting %v, got %v", 4, len(events))
+		return
+	}
+	// A Pair{} taken before restoring does not belong to the Collection
+	if pair.Index() != -1 {
+		t.Error("collection.Restore() keeps the old Pair{}")
+		t.Errorf("Expecting %v, got %v", -1, pair.Index())
+		return
+	}
+	// The Snapshot can be restored more than once
+	collection.Clear().Restore(snapshot)
+	if collection.String() != expecting || snapshot.Length() != 3 {
+		t.Error("collection.Restore() value does not match")
+		t.Errorf("Expecting %v, got %v", expecting, collection)
+		return
+	}
+}
+
+func TestCollection_Snapshot(t *testing.T) {
+	collection := New().Set("a", 1).Set("b", 2)
+	snapshot := collection.Snapshot()
+	if collection.Snapshot() != snapshot || snapshot.Length() != 2 {
+		t.Error("collection.Snapshot() does not share the unchanged Collection")
+		return
+	}
+	changes := []func(){
+		func() { collection.PairOf("a").SetValue(10) },
+		func() { collection.Rename("b", "c") },
+		func() { collection.Swap("a", "c") },
+		func() { collection.Set("d", 4) },
+		func() { collection.Delete("a") },
+		func() { collection.Clear() },
+	}
+	expecting := "{\"a\":1,\"b\":2}"
+	for _, change := range changes {
+		snapshot := collection.Restore(snapshot).Snapshot()
+		change()
+		restored := New().Restore(snapshot)
+		if restored.String() != expecting {
+			t.Error("collection.Snapshot() changes with the Collection")
+			t.Errorf("Expecting %v, got %v", expecting, restored)
+			return
+		}
+	}
+	if collection.Snapshot() == snapshot {
+		t.Error("collection.Snapshot() shares the changed Collection")
+		return
+	}
+}
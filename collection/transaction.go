@@ -0,0 +1,186 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package collection
+
+import (
+	"errors"
+)
+
+var (
+	TransactionDoneError = errors.New("the transaction has already been committed or rolled back")
+)
+
+// The Begin() function starts a new Transaction on the Collection. The
+// operations of the Transaction are staged, and only applied to the
+// Collection by the Commit() function, so the Collection is left unchanged
+// if the Transaction is rolled back.
+//
+// The operations are applied on the state of the Collection at the time
+// of the commit, in the same order as they were staged. A change made to
+// the Collection after Begin() function is therefore kept, unless a staged
+// operation overwrites it.
+func (collection *Collection) Begin() *Transaction {
+	return &Transaction{
+		collection: collection,
+		staged:     collection.Slice(0, len(collection.pairs)),
+	}
+}
+
+// Transaction defines a set of staged operations on a Collection, which
+// are either applied together by the Commit() function, or discarded
+// together by the Rollback() function. The staging functions panic with
+// TransactionDoneError once the Transaction is committed or rolled back.
+type Transaction struct {
+	collection *Collection
+	// staged holds the state of the Collection with the operations
+	// applied, which is read by Get(), Has() and Keys() functions.
+	staged     *Collection
+	operations []func(collection *Collection)
+	isDone     bool
+}
+
+// The Add() function stages the Collection.Add() function.
+func (transaction *Transaction) Add(key interface{}, value interface{}) *Transaction {
+	return transaction.stage(func(collection *Collection) {
+		collection.Add(key, value)
+	})
+}
+
+// The Commit() function applies every staged operation to the Collection.
+// If the Collection is observed, the handlers receive the events of the
+// whole Transaction in a single call. If the Transaction is already
+// committed or rolled back, it will returns TransactionDoneError.
+func (transaction *Transaction) Commit() error {
+	if transaction.isDone {
+		return TransactionDoneError
+	}
+	transaction.isDone = true
+	collection := transaction.collection
+	apply := func() {
+		for _, operation := range transaction.operations {
+			operation(collection)
+		}
+	}
+	if collection.observation != nil {
+		collection.Batch(apply)
+	} else {
+		apply()
+	}
+	transaction.staged, transaction.operations = nil, nil
+	return nil
+}
+
+// The Delete() function stages the Collection.Delete() function.
+func (transaction *Transaction) Delete(key interface{}) *Transaction {
+	return transaction.stage(func(collection *Collection) {
+		collection.Delete(key)
+	})
+}
+
+// The Get() function returns the value of the given key, as it would be
+// after the Transaction is committed.
+func (transaction *Transaction) Get(key interface{}) interface{} {
+	transaction.check()
+	return transaction.staged.Get(key)
+}
+
+// The Has() function determines whether the given key would exist after
+// the Transaction is committed.
+func (transaction *Transaction) Has(key interface{}) bool {
+	transaction.check()
+	return transaction.staged.Has(key)
+}
+
+// The InsertAt() function stages the Collection.InsertAt() function.
+func (transaction *Transaction) InsertAt(index int, key interface{}, value interface{}) *Transaction {
+	return transaction.stage(func(collection *Collection) {
+		collection.InsertAt(index, key, value)
+	})
+}
+
+// The Keys() function returns the keys in the insertion order they would
+// have after the Transaction is committed.
+func (transaction *Transaction) Keys() []interface{} {
+	transaction.check()
+	return transaction.staged.Keys()
+}
+
+// The MoveAfter() function stages the Collection.MoveAfter() function.
+func (transaction *Transaction) MoveAfter(key interface{}, anchor interface{}) *Transaction {
+	return transaction.stage(func(collection *Collection) {
+		collection.MoveAfter(key, anchor)
+	})
+}
+
+// The MoveBefore() function stages the Collection.MoveBefore() function.
+func (transaction *Transaction) MoveBefore(key interface{}, anchor interface{}) *Transaction {
+	return transaction.stage(func(collection *Collection) {
+		collection.MoveBefore(key, anchor)
+	})
+}
+
+// The MoveToBack() function stages the Collection.MoveToBack() function.
+func (transaction *Transaction) MoveToBack(key interface{}) *Transaction {
+	return transaction.stage(func(collection *Collection) {
+		collection.MoveToBack(key)
+	})
+}
+
+// The MoveToFront() function stages the Collection.MoveToFront() function.
+func (transaction *Transaction) MoveToFront(key interface{}) *Transaction {
+	return transaction.stage(func(collection *Collection) {
+		collection.MoveToFront(key)
+	})
+}
+
+// The Rename() function stages the Collection.Rename() function.
+func (transaction *Transaction) Rename(oldKey interface{}, newKey interface{}) *Transaction {
+	return transaction.stage(func(collection *Collection) {
+		collection.Rename(oldKey, newKey)
+	})
+}
+
+// The Rollback() function discards every staged operation, leaving the
+// Collection unchanged. If the Transaction is already committed or rolled
+// back, it will returns TransactionDoneError.
+func (transaction *Transaction) Rollback() error {
+	if transaction.isDone {
+		return TransactionDoneError
+	}
+	transaction.isDone = true
+	transaction.staged, transaction.operations = nil, nil
+	return nil
+}
+
+// The Set() function stages the Collection.Set() function.
+func (transaction *Transaction) Set(key interface{}, value interface{}) *Transaction {
+	return transaction.stage(func(collection *Collection) {
+		collection.Set(key, value)
+	})
+}
+
+// The Swap() function stages the Collection.Swap() function.
+func (transaction *Transaction) Swap(a interface{}, b interface{}) *Transaction {
+	return transaction.stage(func(collection *Collection) {
+		collection.Swap(a, b)
+	})
+}
+
+// The stage() function applies the operation to the staged state, which
+// also panics on an invalid key before the operation is recorded, and
+// records the operation to be applied by the Commit() function.
+func (transaction *Transaction) stage(operation func(collection *Collection)) *Transaction {
+	transaction.check()
+	operation(transaction.staged)
+	transaction.operations = append(transaction.operations, operation)
+	return transaction
+}
+
+func (transaction *Transaction) check() {
+	if transaction.isDone {
+		panic(TransactionDoneError)
+	}
+}
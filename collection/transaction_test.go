@@ -0,0 +1,103 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package collection
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTransaction_Commit(t *testing.T) {
+	collection := New().Set("a", 1).Set("b", 2)
+	var calls [][]Event
+	collection.OnChange(func(events []Event) {
+		calls = append(calls, events)
+	})
+	transaction := collection.Begin().
+		Set("a", 10).
+		Add("c", 3).
+		Delete("b").
+		MoveToFront("c")
+	if collection.Get("a") != 1 || collection.Has("c") || len(calls) != 0 {
+		t.Error("transaction.Set() changes the Collection before committing")
+		t.Errorf("Expecting %v, got %v", "{\"a\":1,\"b\":2}", collection)
+		return
+	}
+	if !reflect.DeepEqual(transaction.Keys(), []interface{}{"c", "a"}) || transaction.Get("a") != 10 || transaction.Has("b") {
+		t.Error("transaction.Keys() staged state does not match")
+		t.Errorf("Expecting %v, got %v", []interface{}{"c", "a"}, transaction.Keys())
+		return
+	}
+	if err := transaction.Commit(); err != nil {
+		t.Error("transaction.Commit() failed to commit")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	expecting := "{\"c\":3,\"a\":10}"
+	if collection.String() != expecting {
+		t.Error("transaction.Commit() value does not match")
+		t.Errorf("Expecting %v, got %v", expecting, collection)
+		return
+	}
+	if len(calls) != 1 || len(calls[0]) != 4 {
+		t.Error("transaction.Commit() does not deliver the events in a single call")
+		t.Errorf("Expecting %v, got %v", 1, len(calls))
+		return
+	}
+	if err := transaction.Commit(); err != TransactionDoneError {
+		t.Error("transaction.Commit() does not return TransactionDoneError")
+		t.Errorf("Expecting %v, got %v", TransactionDoneError, err)
+		return
+	}
+}
+
+func TestTransaction_Rollback(t *testing.T) {
+	collection := New().Set("a", 1).Set("b", 2)
+	transaction := collection.Begin().Rename("a", "z").Swap("z", "b")
+	if err := transaction.Rollback(); err != nil {
+		t.Error("transaction.Rollback() failed to roll back")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	expecting := "{\"a\":1,\"b\":2}"
+	if collection.String() != expecting {
+		t.Error("transaction.Rollback() changes the Collection")
+		t.Errorf("Expecting %v, got %v", expecting, collection)
+		return
+	}
+	if err := transaction.Rollback(); err != TransactionDoneError {
+		t.Error("transaction.Rollback() does not return TransactionDoneError")
+		t.Errorf("Expecting %v, got %v", TransactionDoneError, err)
+		return
+	}
+	defer func() {
+		if recovered := recover(); recovered != TransactionDoneError {
+			t.Error("transaction.Set() does not panic after rolling back")
+			t.Errorf("Expecting %v, got %v", TransactionDoneError, recovered)
+		}
+	}()
+	transaction.Set("c", 3)
+}
+
+func TestTransaction_Set(t *testing.T) {
+	collection := New()
+	transaction := collection.Begin()
+	func() {
+		defer func() {
+			if recovered := recover(); recovered != NonComparableKeyError {
+				t.Error("transaction.Set() does not panic with NonComparableKeyError")
+				t.Errorf("Expecting %v, got %v", NonComparableKeyError, recovered)
+			}
+		}()
+		transaction.Set([]int{1}, "slice")
+	}()
+	// The invalid operation is not staged, so the rest can be committed
+	if err := transaction.Set("a", 1).Commit(); err != nil || collection.Length() != 1 {
+		t.Error("transaction.Commit() value does not match")
+		t.Errorf("Expecting %v, got %v", "{\"a\":1}", collection)
+		return
+	}
+}
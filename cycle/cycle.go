@@ -0,0 +1,204 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package cycle
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+)
+
+var (
+	CircularReferenceError = errors.New("the given value contains a circular reference")
+)
+
+// Marker is printed by printers in place of a circular value.
+const Marker = "[Circular]"
+
+// OrderedMap defines a map that remembers the order of its keys, such as a
+// Collection.
+type OrderedMap interface {
+	Keys() []interface{}
+	Get(key interface{}) interface{}
+}
+
+// PathError describes a circular reference found by an encoder. It wraps
+// CircularReferenceError, so it can be checked using errors.Is().
+type PathError struct {
+	// Path is the list of keys and indices from the encoded value down to
+	// the value that refers back to one of its ancestors.
+	Path []interface{}
+}
+
+// The Error() function returns the error message, including the path
+// written as a JSONPath such as $.a[0].
+func (err *PathError) Error() string {
+	return fmt.Sprintf("%s at %s", CircularReferenceError.Error(), FormatPath(err.Path))
+}
+
+// The Unwrap() function returns CircularReferenceError.
+func (err *PathError) Unwrap() error {
+	return CircularReferenceError
+}
+
+var identifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// The FormatPath() function writes the given path as a JSONPath, where an
+// int is written as an index, an identifier as a member name, and any other
+// key as a quoted member name.
+func FormatPath(path []interface{}) string {
+	formatted := "$"
+	for _, key := range path {
+		switch key := key.(type) {
+		case int:
+			formatted += "[" + strconv.Itoa(key) + "]"
+		case string:
+			if identifierPattern.MatchString(key) {
+				formatted += "." + key
+			} else {
+				formatted += "[" + strconv.Quote(key) + "]"
+			}
+		default:
+			formatted += "[" + strconv.Quote(fmt.Sprintf("%v", key)) + "]"
+		}
+	}
+	return formatted
+}
+
+// The Check() function walks the given value, and returns a PathError if it
+// contains a circular reference.
+func Check(v interface{}) error {
+	return (&Tracker{}).check(v)
+}
+
+// Tracker keeps the values that are being visited and the path to the
+// current value. The zero value is ready to use.
+type Tracker struct {
+	active map[reference]bool
+	path   []interface{}
+}
+
+// reference identifies the memory of a value. A slice is identified by its
+// length as well, so a slice and a shorter slice of it are different.
+type reference struct {
+	kind    reflect.Kind
+	pointer uintptr
+	length  int
+}
+
+// The Enter() function marks the given value as being visited, and returns
+// true. If the value is already being visited, it returns false without
+// marking it, which means the value is circular. A value that cannot hold
+// other values is never marked.
+func (tracker *Tracker) Enter(value interface{}) bool {
+	key, ok := referenceOf(value)
+	if !ok {
+		return true
+	}
+	if tracker.active[key] {
+		return false
+	}
+	if tracker.active == nil {
+		tracker.active = make(map[reference]bool)
+	}
+	tracker.active[key] = true
+	return true
+}
+
+// The Error() function returns a PathError holding the current path.
+func (tracker *Tracker) Error() error {
+	return &PathError{Path: tracker.Path()}
+}
+
+// The Leave() function removes the mark set by Enter() function, once the
+// children of the value have been visited.
+func (tracker *Tracker) Leave(value interface{}) {
+	if key, ok := referenceOf(value); ok {
+		delete(tracker.active, key)
+	}
+}
+
+// The Path() function returns a copy of the current path.
+func (tracker *Tracker) Path() []interface{} {
+	return append([]interface{}{}, tracker.path...)
+}
+
+// The Pop() function removes the last key from the current path.
+func (tracker *Tracker) Pop() {
+	tracker.path = tracker.path[:len(tracker.path)-1]
+}
+
+// The Push() function adds the key of the child being visited to the
+// current path.
+func (tracker *Tracker) Push(key interface{}) {
+	tracker.path = append(tracker.path, key)
+}
+
+func (tracker *Tracker) check(v interface{}) error {
+	if !tracker.Enter(v) {
+		return tracker.Error()
+	}
+	defer tracker.Leave(v)
+	if ordered, ok := v.(OrderedMap); ok && !isNil(v) {
+		for _, key := range ordered.Keys() {
+			if err := tracker.checkChild(key, ordered.Get(key)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	reflection := reflect.ValueOf(v)
+	switch reflection.Kind() {
+	case reflect.Slice, reflect.Array:
+		for index := 0; index < reflection.Len(); index++ {
+			if err := tracker.checkChild(index, reflection.Index(index).Interface()); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		iterator := reflection.MapRange()
+		for iterator.Next() {
+			if err := tracker.checkChild(iterator.Key().Interface(), iterator.Value().Interface()); err != nil {
+				return err
+			}
+		}
+	case reflect.Ptr:
+		if !reflection.IsNil() {
+			return tracker.check(reflection.Elem().Interface())
+		}
+	}
+	return nil
+}
+
+func (tracker *Tracker) checkChild(key interface{}, value interface{}) error {
+	tracker.Push(key)
+	defer tracker.Pop()
+	return tracker.check(value)
+}
+
+func referenceOf(value interface{}) (reference, bool) {
+	reflection := reflect.ValueOf(value)
+	switch reflection.Kind() {
+	case reflect.Map, reflect.Ptr:
+		if reflection.IsNil() {
+			return reference{}, false
+		}
+		return reference{kind: reflection.Kind(), pointer: reflection.Pointer()}, true
+	case reflect.Slice:
+		if reflection.Len() == 0 {
+			return reference{}, false
+		}
+		return reference{kind: reflect.Slice, pointer: reflection.Pointer(), length: reflection.Len()}, true
+	}
+	return reference{}, false
+}
+
+func isNil(value interface{}) bool {
+	reflection := reflect.ValueOf(value)
+	return reflection.Kind() == reflect.Ptr && reflection.IsNil()
+}
@@ -0,0 +1,102 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package cycle
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type orderedMap map[interface{}]interface{}
+
+func (ordered orderedMap) Keys() []interface{} {
+	var keys []interface{}
+	for key := range ordered {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func (ordered orderedMap) Get(key interface{}) interface{} {
+	return ordered[key]
+}
+
+func TestCheck(t *testing.T) {
+	shared := []interface{}{1}
+	if err := Check(map[string]interface{}{"a": shared, "b": shared, "c": nil}); err != nil {
+		t.Error("Check() of a shared value does not match")
+		t.Errorf("Expecting %v, got %v", nil, err)
+		return
+	}
+	circular := map[string]interface{}{}
+	circular["a"] = []interface{}{0, map[string]interface{}{"b": circular}}
+	err := Check(circular)
+	var pathError *PathError
+	if !errors.As(err, &pathError) || !errors.Is(err, CircularReferenceError) {
+		t.Error("Check() error does not match")
+		t.Errorf("Expecting %v, got %v", CircularReferenceError, err)
+		return
+	}
+	expected := []interface{}{"a", 1, "b"}
+	if !reflect.DeepEqual(pathError.Path, expected) {
+		t.Error("Check() error path does not match")
+		t.Errorf("Expecting %v, got %v", expected, pathError.Path)
+		return
+	}
+	if err.Error() != "the given value contains a circular reference at $.a[1].b" {
+		t.Error("Check() error message does not match")
+		t.Errorf("Expecting %v, got %v", "... at $.a[1].b", err.Error())
+		return
+	}
+}
+
+func TestCheck_orderedMap(t *testing.T) {
+	ordered := orderedMap{}
+	ordered[1] = &ordered
+	if err := Check(ordered); err == nil {
+		t.Error("Check() of an OrderedMap does not match")
+		return
+	}
+	self := make([]interface{}, 1)
+	self[0] = self
+	if err := Check(self); err == nil {
+		t.Error("Check() of a slice does not match")
+		return
+	}
+}
+
+func TestFormatPath(t *testing.T) {
+	formatted := FormatPath([]interface{}{"a", 0, "b c", int64(2)})
+	expected := "$.a[0][\"b c\"][\"2\"]"
+	if formatted != expected {
+		t.Error("FormatPath() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, formatted)
+		return
+	}
+}
+
+func TestTracker(t *testing.T) {
+	tracker := &Tracker{}
+	value := map[string]interface{}{}
+	if !tracker.Enter(value) || tracker.Enter(value) || !tracker.Enter(1) {
+		t.Error("tracker.Enter() value does not match")
+		return
+	}
+	tracker.Leave(value)
+	if !tracker.Enter(value) {
+		t.Error("tracker.Enter() after tracker.Leave() does not match")
+		return
+	}
+	tracker.Push("a")
+	tracker.Push(0)
+	tracker.Pop()
+	if !reflect.DeepEqual(tracker.Path(), []interface{}{"a"}) {
+		t.Error("tracker.Path() value does not match")
+		t.Errorf("Expecting %v, got %v", []interface{}{"a"}, tracker.Path())
+		return
+	}
+}
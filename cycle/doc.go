@@ -0,0 +1,22 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+/*
+The Cycle detects circular references in nested values, such as a
+Collection that holds itself, either directly or through one of its
+children. A value is circular when it is found again while one of its
+children is being visited, so a value that is shared by two siblings is not
+a cycle.
+
+A function that recurses into nested values uses a Tracker to know whether
+a value is already being visited. Encoders that cannot represent a cycle
+return a PathError, which tells the path to the value where the cycle was
+found, and printers print the Marker instead of the value.
+
+The slices, arrays, maps and pointers are followed, as well as any value
+that implements OrderedMap, such as a Collection. The fields of a struct
+are not followed.
+*/
+package cycle
@@ -0,0 +1,19 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+/*
+The CSV converts an Array of records into comma-separated values and back.
+It is built on top of the "encoding/csv" package.
+
+Each record of the Array is written as a single row, and each record may be
+a Collection, an Object or any native map. Nested Arrays, Objects and
+Collections are flattened into dotted column names, so a record like
+{"user":{"name":"a"},"tags":["x","y"]} is written into the columns named
+"user.name", "tags.0" and "tags.1".
+
+Reading a CSV returns an Array of Collections, where every Collection keeps
+the column order of the header.
+*/
+package csv
@@ -0,0 +1,130 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package csv
+
+import (
+	"bytes"
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/with-go/standard/array"
+	"github.com/with-go/standard/collection"
+)
+
+// ReaderOptions defines the options used by a Reader.
+type ReaderOptions struct {
+	// Columns is the explicit list of columns to read. If it is empty, the
+	// first record is used as the header.
+	Columns []string
+	// Comma is the field delimiter. It defaults to ','.
+	Comma rune
+	// InferTypes converts fields that look like integers, floating-point
+	// numbers or booleans into int64, float64 and bool values. Otherwise,
+	// every field is kept as a string.
+	InferTypes bool
+}
+
+// The Unmarshal() function parses the given CSV data, using its first record
+// as the header, and returns an Array of Collections.
+func Unmarshal(data []byte) (array.Array, error) {
+	return UnmarshalWithOptions(data, ReaderOptions{})
+}
+
+// The UnmarshalWithOptions() function is the same as Unmarshal() function,
+// but uses the given options.
+func UnmarshalWithOptions(data []byte, options ReaderOptions) (array.Array, error) {
+	return NewReader(bytes.NewReader(data), options).ReadAll()
+}
+
+// The NewReader() function creates a new Reader that reads from r.
+func NewReader(r io.Reader, options ReaderOptions) *Reader {
+	reader := csv.NewReader(r)
+	if options.Comma != 0 {
+		reader.Comma = options.Comma
+	}
+	return &Reader{reader, options, options.Columns}
+}
+
+// Reader defines a Reader Type, which reads CSV records as Collections.
+type Reader struct {
+	reader  *csv.Reader
+	options ReaderOptions
+	columns []string
+}
+
+// The Columns() function returns the columns of the Reader. If the columns
+// are not explicitly given, it returns nil until the first row is read.
+func (reader *Reader) Columns() []string {
+	return reader.columns
+}
+
+// The Read() function reads a single record and returns it as a Collection,
+// where the keys are the columns in header order. If there is no record
+// left, it will returns io.EOF.
+func (reader *Reader) Read() (*collection.Collection, error) {
+	if len(reader.columns) == 0 {
+		header, err := reader.reader.Read()
+		if err != nil {
+			return nil, err
+		}
+		reader.columns = header
+	}
+	record, err := reader.reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	row := collection.New()
+	for index, column := range reader.columns {
+		var value interface{}
+		if index < len(record) {
+			value = record[index]
+			if reader.options.InferTypes {
+				value = infer(record[index])
+			}
+		}
+		row.Set(column, value)
+	}
+	return row, nil
+}
+
+// The ReadAll() function reads every remaining record, and returns them as
+// an Array of Collections.
+func (reader *Reader) ReadAll() (array.Array, error) {
+	rows := array.New()
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		rows.PushInPlace(row)
+	}
+}
+
+// The infer() function converts a field into an int64, a float64 or a bool
+// if possible. Otherwise, the field is returned as it is.
+func infer(field string) interface{} {
+	if strings.EqualFold(field, "true") {
+		return true
+	}
+	if strings.EqualFold(field, "false") {
+		return false
+	}
+	if strings.TrimLeft(field, "+-.") == "" || !strings.ContainsAny(field[len(field)-1:], "0123456789") {
+		return field
+	}
+	if value, err := strconv.ParseInt(field, 10, 64); err == nil {
+		return value
+	}
+	if value, err := strconv.ParseFloat(field, 64); err == nil {
+		return value
+	}
+	return field
+}
@@ -0,0 +1,79 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package csv
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var testCsv = "name,count,ratio,active,note\n" +
+	"alpha,10,0.5,true,\n" +
+	"beta,-3,1e3,FALSE,NaN\n"
+
+func TestUnmarshal(t *testing.T) {
+	rows, err := Unmarshal([]byte(testCsv))
+	if err != nil {
+		t.Error("Unmarshal(data) failed to read CSV")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	if rows.Length() != 2 {
+		t.Error("Unmarshal(data) length does not match")
+		t.Errorf("Expecting %v, got %v", 2, rows.Length())
+		return
+	}
+	expecting := "[{\"name\":\"alpha\",\"count\":\"10\",\"ratio\":\"0.5\",\"active\":\"true\",\"note\":\"\"} " +
+		"{\"name\":\"beta\",\"count\":\"-3\",\"ratio\":\"1e3\",\"active\":\"FALSE\",\"note\":\"NaN\"}]"
+	if fmt.Sprint(rows.Values()) != expecting {
+		t.Error("Unmarshal(data) rows do not match")
+		t.Errorf("Expecting %v, got %v", expecting, fmt.Sprint(rows.Values()))
+		return
+	}
+}
+
+func TestUnmarshalWithOptions(t *testing.T) {
+	rows, err := UnmarshalWithOptions([]byte(testCsv), ReaderOptions{InferTypes: true})
+	if err != nil {
+		t.Error("UnmarshalWithOptions(data, options) failed to read CSV")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	expecting := "[{\"name\":\"alpha\",\"count\":10,\"ratio\":0.5,\"active\":true,\"note\":\"\"} " +
+		"{\"name\":\"beta\",\"count\":-3,\"ratio\":1000,\"active\":false,\"note\":\"NaN\"}]"
+	if fmt.Sprint(rows.Values()) != expecting {
+		t.Error("UnmarshalWithOptions(data, options) rows do not match")
+		t.Errorf("Expecting %v, got %v", expecting, fmt.Sprint(rows.Values()))
+		return
+	}
+}
+
+func TestReader_Read(t *testing.T) {
+	reader := NewReader(strings.NewReader("1;2\n3;4\n"), ReaderOptions{
+		Columns: []string{"a", "b"},
+		Comma:   ';',
+	})
+	row, err := reader.Read()
+	if err != nil {
+		t.Error("reader.Read() failed to read a row")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	if !reflect.DeepEqual(row.Keys(), []interface{}{"a", "b"}) || row.Get("a") != "1" {
+		t.Error("reader.Read() row does not match")
+		t.Errorf("Expecting %v, got %v", "{\"a\":\"1\",\"b\":\"2\"}", row)
+		return
+	}
+	_, _ = reader.Read()
+	if _, err := reader.Read(); err != io.EOF {
+		t.Error("reader.Read() does not return io.EOF at the end")
+		t.Errorf("Expecting %v, got %v", io.EOF, err)
+		return
+	}
+}
@@ -0,0 +1,252 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package csv
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/with-go/standard/array"
+	"github.com/with-go/standard/collection"
+	"github.com/with-go/standard/cycle"
+	"github.com/with-go/standard/object"
+)
+
+var (
+	NonMapRowError = errors.New("the given row is a non-map type, " +
+		"row should be a Collection, an Object or a map")
+)
+
+// WriterOptions defines the options used by a Writer.
+type WriterOptions struct {
+	// Columns is the explicit list of columns to write. If it is empty, the
+	// columns are derived from the first row: a Collection gives its key
+	// order, while an Object or a map gives its keys sorted alphabetically.
+	Columns []string
+	// Comma is the field delimiter. It defaults to ','.
+	Comma rune
+	// Separator joins the keys of nested values into a column name. It
+	// defaults to ".".
+	Separator string
+}
+
+// The Marshal() function returns the CSV encoding of the given rows,
+// including the header.
+func Marshal(rows array.Array) ([]byte, error) {
+	return MarshalWithOptions(rows, WriterOptions{})
+}
+
+// The MarshalWithOptions() function is the same as Marshal() function, but
+// uses the given options.
+func MarshalWithOptions(rows array.Array, options WriterOptions) ([]byte, error) {
+	buffer := &bytes.Buffer{}
+	writer := NewWriter(buffer, options)
+	if err := writer.WriteAll(rows); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// The NewWriter() function creates a new Writer that writes to w.
+func NewWriter(w io.Writer, options WriterOptions) *Writer {
+	writer := csv.NewWriter(w)
+	if options.Comma != 0 {
+		writer.Comma = options.Comma
+	}
+	if options.Separator == "" {
+		options.Separator = "."
+	}
+	return &Writer{writer, options, options.Columns, false}
+}
+
+// Writer defines a Writer Type, which writes rows as CSV records. The
+// header is written right before the first row.
+type Writer struct {
+	writer  *csv.Writer
+	options WriterOptions
+	columns []string
+	started bool
+}
+
+// The Columns() function returns the columns of the Writer. If the columns
+// are not explicitly given, it returns nil until the first row is written.
+func (writer *Writer) Columns() []string {
+	return writer.columns
+}
+
+// The Flush() function writes any buffered data to the underlying writer,
+// and returns any error that occurred during a previous write or flush.
+func (writer *Writer) Flush() error {
+	writer.writer.Flush()
+	return writer.writer.Error()
+}
+
+// The Write() function writes a single row, preceded by the header if it is
+// the first row written. Flattened columns of the row that are not part of
+// the header are ignored, and columns of the header missing from the row
+// are written as empty fields. If the row is not a Collection, an Object or
+// a map, it will returns NonMapRowError, and if the row holds a circular
+// reference, it will returns a cycle.PathError.
+func (writer *Writer) Write(row interface{}) error {
+	columns, values, err := flattenRow(row, writer.options.Separator)
+	if err != nil {
+		return err
+	}
+	if !writer.started {
+		if len(writer.columns) == 0 {
+			writer.columns = columns
+		}
+		if err := writer.writer.Write(writer.columns); err != nil {
+			return err
+		}
+		writer.started = true
+	}
+	record := make([]string, len(writer.columns))
+	for index, column := range writer.columns {
+		record[index] = values[column]
+	}
+	return writer.writer.Write(record)
+}
+
+// The WriteAll() function writes every given row using Write() and then
+// flushes the Writer. If a row holds a circular reference, the Path of the
+// returned cycle.PathError starts with the index of the row.
+func (writer *Writer) WriteAll(rows array.Array) error {
+	for index, row := range rows {
+		if err := writer.Write(row); err != nil {
+			if pathError, ok := err.(*cycle.PathError); ok {
+				pathError.Path = append([]interface{}{index}, pathError.Path...)
+			}
+			return err
+		}
+	}
+	return writer.Flush()
+}
+
+// The flattenRow() function flattens a row into its column names, in order,
+// and the formatted value of each column.
+func flattenRow(row interface{}, separator string) ([]string, map[string]string, error) {
+	reflection := reflect.ValueOf(row)
+	if _, ok := row.(*collection.Collection); !ok && reflection.Kind() != reflect.Map {
+		return nil, nil, NonMapRowError
+	}
+	if err := cycle.Check(row); err != nil {
+		return nil, nil, err
+	}
+	var columns []string
+	values := make(map[string]string)
+	flatten("", row, separator, &columns, values)
+	return columns, values, nil
+}
+
+func flatten(prefix string, value interface{}, separator string, columns *[]string, values map[string]string) {
+	join := func(key string) string {
+		if prefix == "" {
+			return key
+		}
+		return prefix + separator + key
+	}
+	isEmpty := true
+	switch value := value.(type) {
+	case *collection.Collection:
+		if value == nil {
+			break
+		}
+		value.ForEach(func(key interface{}, element interface{}) {
+			isEmpty = false
+			flatten(join(collection.KeyString(key)), element, separator, columns, values)
+		})
+	case object.Object:
+		value.ForEach(func(key string, element interface{}) {
+			isEmpty = false
+			flatten(join(key), element, separator, columns, values)
+		})
+	case array.Array:
+		for index, element := range value {
+			isEmpty = false
+			flatten(join(strconv.Itoa(index)), element, separator, columns, values)
+		}
+	default:
+		reflection := reflect.ValueOf(value)
+		switch reflection.Kind() {
+		case reflect.Map:
+			keys := make([]string, 0, reflection.Len())
+			elements := make(map[string]interface{})
+			for _, key := range reflection.MapKeys() {
+				name := fmt.Sprint(key.Interface())
+				keys = append(keys, name)
+				elements[name] = reflection.MapIndex(key).Interface()
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				isEmpty = false
+				flatten(join(key), elements[key], separator, columns, values)
+			}
+		case reflect.Slice, reflect.Array:
+			if reflection.Type().Elem().Kind() == reflect.Uint8 {
+				addColumn(prefix, format(value), columns, values)
+				return
+			}
+			for index := 0; index < reflection.Len(); index++ {
+				isEmpty = false
+				flatten(join(strconv.Itoa(index)), reflection.Index(index).Interface(), separator, columns, values)
+			}
+		default:
+			addColumn(prefix, format(value), columns, values)
+			return
+		}
+	}
+	// An empty nested container is still written as an empty column, so the
+	// column is not lost from the header.
+	if isEmpty && prefix != "" {
+		addColumn(prefix, "", columns, values)
+	}
+}
+
+func addColumn(column string, value string, columns *[]string, values map[string]string) {
+	if _, exists := values[column]; !exists {
+		*columns = append(*columns, column)
+	}
+	values[column] = value
+}
+
+// The format() function formats a scalar value as a CSV field. A nil value
+// is written as an empty field.
+func format(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+	reflection := reflect.ValueOf(value)
+	switch reflection.Kind() {
+	case reflect.String:
+		return reflection.String()
+	case reflect.Bool:
+		return strconv.FormatBool(reflection.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(reflection.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(reflection.Uint(), 10)
+	case reflect.Float32:
+		return strconv.FormatFloat(reflection.Float(), 'f', -1, 32)
+	case reflect.Float64:
+		return strconv.FormatFloat(reflection.Float(), 'f', -1, 64)
+	case reflect.Ptr, reflect.Interface:
+		if reflection.IsNil() {
+			return ""
+		}
+	case reflect.Slice:
+		if reflection.Type().Elem().Kind() == reflect.Uint8 {
+			return string(reflection.Bytes())
+		}
+	}
+	return fmt.Sprint(value)
+}
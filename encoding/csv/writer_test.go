@@ -0,0 +1,107 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package csv
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/with-go/standard/array"
+	"github.com/with-go/standard/collection"
+	"github.com/with-go/standard/cycle"
+	"github.com/with-go/standard/object"
+)
+
+func TestMarshal(t *testing.T) {
+	rows := array.New(
+		collection.New().
+			Set("name", "alpha").
+			Set("user", collection.New().Set("id", 1).Set("email", "a@example.com")).
+			Set("tags", array.New("x", "y")).
+			Set("score", 1.5),
+		collection.New().
+			Set("score", 2).
+			Set("name", "beta, gamma").
+			Set("extra", true))
+	data, err := Marshal(rows)
+	if err != nil {
+		t.Error("Marshal(rows) failed to write CSV")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	expecting := "name,user.id,user.email,tags.0,tags.1,score\n" +
+		"alpha,1,a@example.com,x,y,1.5\n" +
+		"\"beta, gamma\",,,,,2\n"
+	if string(data) != expecting {
+		t.Error("Marshal(rows) CSV does not match")
+		t.Errorf("Expecting %v, got %v", expecting, string(data))
+		return
+	}
+}
+
+func TestMarshal_circular(t *testing.T) {
+	values := []interface{}{"x", nil}
+	values[1] = values
+	rows := array.New(object.New().Set("a", 1), object.New().Set("a", 2).Set("c", values))
+	_, err := Marshal(rows)
+	var pathError *cycle.PathError
+	if !errors.As(err, &pathError) || !reflect.DeepEqual(pathError.Path, []interface{}{1, "c", 1}) {
+		t.Error("Marshal() error does not match")
+		t.Errorf("Expecting %v, got %v", "$[1].c[1]", err)
+		return
+	}
+}
+
+func TestMarshalWithOptions(t *testing.T) {
+	rows := array.New(
+		object.New().Set("b", 2).Set("a", nil).Set("c", object.New().Set("d", "e")),
+		map[string]interface{}{"a": "x", "b": false})
+	data, err := MarshalWithOptions(rows, WriterOptions{Comma: ';', Separator: "_"})
+	if err != nil {
+		t.Error("MarshalWithOptions(rows, options) failed to write CSV")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	expecting := "a;b;c_d\n;2;e\nx;false;\n"
+	if string(data) != expecting {
+		t.Error("MarshalWithOptions(rows, options) CSV does not match")
+		t.Errorf("Expecting %v, got %v", expecting, string(data))
+		return
+	}
+	data, _ = MarshalWithOptions(rows, WriterOptions{Columns: []string{"c_d", "b"}, Separator: "_"})
+	expecting = "c_d,b\ne,2\n,false\n"
+	if string(data) != expecting {
+		t.Error("MarshalWithOptions(rows, options) CSV with columns does not match")
+		t.Errorf("Expecting %v, got %v", expecting, string(data))
+		return
+	}
+}
+
+func TestWriter_Write(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	writer := NewWriter(buffer, WriterOptions{})
+	if err := writer.Write("not a row"); err != NonMapRowError {
+		t.Error("writer.Write(row) accepted a non-map row")
+		t.Errorf("Expecting %v, got %v", NonMapRowError, err)
+		return
+	}
+	_ = writer.Write(collection.New().Set("id", 1).Set("empty", array.New()))
+	columns := []string{"id", "empty"}
+	if !reflect.DeepEqual(writer.Columns(), columns) {
+		t.Error("writer.Write(row) columns do not match")
+		t.Errorf("Expecting %v, got %v", columns, writer.Columns())
+		return
+	}
+	_ = writer.Flush()
+	expecting := "id,empty\n1,\n"
+	if buffer.String() != expecting {
+		t.Error("writer.Write(row) CSV does not match")
+		t.Errorf("Expecting %v, got %v", expecting, buffer.String())
+		return
+	}
+}
@@ -0,0 +1,20 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+/*
+The QS parses and stringifies query strings with nested maps and arrays,
+following the conventions of the "qs" JavaScript library. A query string
+such as
+
+	filter[status]=open&tags[]=a&tags[]=b
+
+is parsed into a Collection equal to {"filter":{"status":"open"},"tags":["a","b"]},
+where nested maps are Collections and nested arrays are Arrays. Parameters
+are kept in the order they appear in the query string.
+
+Stringifying a Collection keeps its key order, while the keys of an Object
+or a native map are sorted alphabetically.
+*/
+package qs
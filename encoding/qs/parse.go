@@ -0,0 +1,301 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package qs
+
+import (
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/with-go/standard/array"
+	"github.com/with-go/standard/collection"
+	"github.com/with-go/standard/object"
+)
+
+var (
+	bracketPattern = regexp.MustCompile(`\[[^\[\]]*\]`)
+	dotPattern     = regexp.MustCompile(`\.([^.\[]+)`)
+)
+
+// The Parse() function parses the given query string using the
+// DefaultOptions, and returns the result as a Collection. See "qs" package
+// documentation for more information.
+func Parse(query string) (*collection.Collection, error) {
+	return ParseWithOptions(query, DefaultOptions)
+}
+
+// The ParseWithOptions() function is the same as Parse() function, but uses
+// the given options.
+func ParseWithOptions(query string, options Options) (*collection.Collection, error) {
+	root, err := parse(query, options)
+	if err != nil {
+		return nil, err
+	}
+	return finalize(root, false).(*collection.Collection), nil
+}
+
+// The ParseObject() function is the same as Parse() function, but returns
+// the result as an Object. Nested maps are returned as Objects, so the
+// parameter order is not kept.
+func ParseObject(query string) (object.Object, error) {
+	return ParseObjectWithOptions(query, DefaultOptions)
+}
+
+// The ParseObjectWithOptions() function is the same as ParseObject()
+// function, but uses the given options.
+func ParseObjectWithOptions(query string, options Options) (object.Object, error) {
+	root, err := parse(query, options)
+	if err != nil {
+		return nil, err
+	}
+	return finalize(root, true).(object.Object), nil
+}
+
+// The ParseValues() function parses the given url.Values using the
+// DefaultOptions, and returns the result as a Collection. Because url.Values
+// is a native map, the parameters are parsed in alphabetical key order.
+func ParseValues(values url.Values) (*collection.Collection, error) {
+	return Parse(values.Encode())
+}
+
+func parse(query string, options Options) (*collection.Collection, error) {
+	root := collection.New()
+	query = strings.TrimPrefix(query, "?")
+	if query == "" {
+		return root, nil
+	}
+	delimiter := options.Delimiter
+	if delimiter == "" {
+		delimiter = "&"
+	}
+	count := 0
+	for _, part := range strings.Split(query, delimiter) {
+		if part == "" {
+			continue
+		}
+		if options.ParameterLimit > 0 && count >= options.ParameterLimit {
+			if options.StrictLimits {
+				return nil, ParameterLimitError
+			}
+			break
+		}
+		count++
+		// A key like a[=]=b contains an equal sign, so the value starts right
+		// after the first "]=" if there is one.
+		position := strings.Index(part, "]=")
+		if position == -1 {
+			position = strings.Index(part, "=")
+		} else {
+			position++
+		}
+		key, value := part, ""
+		if position != -1 {
+			key, value = part[:position], part[position+1:]
+		}
+		key, value = unescape(key), unescape(value)
+		if key == "" {
+			continue
+		}
+		segments, err := parseKey(key, options)
+		if err != nil {
+			return nil, err
+		}
+		assign(root, segments, value)
+	}
+	return root, nil
+}
+
+func unescape(s string) string {
+	unescaped, err := url.QueryUnescape(s)
+	if err != nil {
+		return s
+	}
+	return unescaped
+}
+
+const (
+	keySegment = iota
+	indexSegment
+	appendSegment
+)
+
+// segment is a single part of a parameter key, such as "b" in a[b]=c.
+type segment struct {
+	kind  int
+	key   string
+	index int
+}
+
+// The parseKey() function splits a parameter key into its segments.
+func parseKey(key string, options Options) ([]segment, error) {
+	if options.AllowDots {
+		key = dotPattern.ReplaceAllString(key, "[$1]")
+	}
+	var raws []string
+	matches := bracketPattern.FindAllStringIndex(key, -1)
+	if options.Depth <= 0 || len(matches) == 0 {
+		raws = append(raws, key)
+	} else {
+		if parent := key[:matches[0][0]]; parent != "" {
+			raws = append(raws, parent)
+		}
+		for index, match := range matches {
+			if index >= options.Depth {
+				if options.StrictLimits {
+					return nil, DepthLimitError
+				}
+				raws = append(raws, "["+key[match[0]:]+"]")
+				break
+			}
+			raws = append(raws, key[match[0]:match[1]])
+		}
+	}
+	segments := make([]segment, len(raws))
+	for index, raw := range raws {
+		if raw == "[]" {
+			segments[index] = segment{kind: appendSegment}
+			continue
+		}
+		if strings.HasPrefix(raw, "[") && strings.HasSuffix(raw, "]") {
+			raw = raw[1 : len(raw)-1]
+		}
+		number, err := strconv.Atoi(raw)
+		if err == nil && strconv.Itoa(number) == raw && number >= 0 && number <= options.ArrayLimit {
+			segments[index] = segment{indexSegment, raw, number}
+			continue
+		}
+		segments[index] = segment{kind: keySegment, key: raw}
+	}
+	return segments, nil
+}
+
+// The assign() function assigns the value into current at the path defined
+// by the segments, and returns the updated current value.
+func assign(current interface{}, segments []segment, value interface{}) interface{} {
+	if len(segments) == 0 {
+		switch current := current.(type) {
+		case nil:
+			return value
+		case *list:
+			current.push(value)
+			return current
+		case *collection.Collection:
+			current.Set(value.(string), true)
+			return current
+		default:
+			return newList(current, value)
+		}
+	}
+	head, rest := segments[0], segments[1:]
+	switch current.(type) {
+	case nil, *list, *collection.Collection:
+		break
+	default:
+		// A parameter nested under a key that already holds a plain value,
+		// such as a=b&a[c]=d, turns the key into an array.
+		leaf := newList(current)
+		if head.kind == keySegment {
+			leaf.push(assign(nil, segments, value))
+			return leaf
+		}
+		current = leaf
+	}
+	if existing, ok := current.(*collection.Collection); ok || head.kind == keySegment {
+		if !ok {
+			existing = toCollection(current)
+		}
+		key := head.key
+		if head.kind == appendSegment {
+			key = strconv.Itoa(existing.Length())
+		}
+		existing.Set(key, assign(existing.Get(key), rest, value))
+		return existing
+	}
+	values, _ := current.(*list)
+	if values == nil {
+		values = newList()
+	}
+	if head.kind == appendSegment {
+		values.push(assign(nil, rest, value))
+	} else {
+		values.set(head.index, assign(values.values[head.index], rest, value))
+	}
+	return values
+}
+
+func toCollection(current interface{}) *collection.Collection {
+	values, ok := current.(*list)
+	if !ok {
+		return collection.New()
+	}
+	converted := collection.New()
+	for _, index := range values.indices() {
+		converted.Set(strconv.Itoa(index), values.values[index])
+	}
+	return converted
+}
+
+// The finalize() function converts every list into an Array, compacting
+// sparse indices, and every map into an Object if asObject is true.
+func finalize(value interface{}, asObject bool) interface{} {
+	switch value := value.(type) {
+	case *list:
+		converted := array.New()
+		for _, index := range value.indices() {
+			converted.PushInPlace(finalize(value.values[index], asObject))
+		}
+		return converted
+	case *collection.Collection:
+		if asObject {
+			converted := object.New()
+			value.ForEach(func(key interface{}, element interface{}) {
+				converted.Set(collection.KeyString(key), finalize(element, asObject))
+			})
+			return converted
+		}
+		value.ForEach(func(key interface{}, element interface{}) {
+			value.Set(key, finalize(element, asObject))
+		})
+		return value
+	}
+	return value
+}
+
+// list is an array under construction, which may be sparse while parsing.
+type list struct {
+	values map[int]interface{}
+	next   int
+}
+
+func newList(values ...interface{}) *list {
+	created := &list{values: make(map[int]interface{})}
+	for _, value := range values {
+		created.push(value)
+	}
+	return created
+}
+
+func (list *list) indices() []int {
+	indices := make([]int, 0, len(list.values))
+	for index := range list.values {
+		indices = append(indices, index)
+	}
+	sort.Ints(indices)
+	return indices
+}
+
+func (list *list) push(value interface{}) {
+	list.set(list.next, value)
+}
+
+func (list *list) set(index int, value interface{}) {
+	list.values[index] = value
+	if index >= list.next {
+		list.next = index + 1
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package qs
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/with-go/standard/array"
+)
+
+func TestParse(t *testing.T) {
+	tests := map[string]string{
+		"filter[status]=open&tags[]=a&tags[]=b": "{\"filter\":{\"status\":\"open\"},\"tags\":[\"a\",\"b\"]}",
+		"?b=2&a=1&b=3":                          "{\"b\":[\"2\",\"3\"],\"a\":\"1\"}",
+		"a[1]=c&a[0]=b&a[5]=d":                  "{\"a\":[\"b\",\"c\",\"d\"]}",
+		"a[100]=b":                              "{\"a\":{\"100\":\"b\"}}",
+		"a[0]=b&a[x]=c":                         "{\"a\":{\"0\":\"b\",\"x\":\"c\"}}",
+		"name=John+Doe&city=New%20York&flag":    "{\"name\":\"John Doe\",\"city\":\"New York\",\"flag\":\"\"}",
+		"a[b][c][d][e][f][g][h]=i":              "{\"a\":{\"b\":{\"c\":{\"d\":{\"e\":{\"f\":{\"[g][h]\":\"i\"}}}}}}}",
+		"a%5Bb%5D=c":                            "{\"a\":{\"b\":\"c\"}}",
+	}
+	for query, expecting := range tests {
+		parsed, err := Parse(query)
+		if err != nil {
+			t.Error("Parse(query) failed to parse query string")
+			t.Errorf("Reason: %s", err.Error())
+			return
+		}
+		if fmt.Sprint(parsed) != expecting {
+			t.Errorf("Parse(query) value does not match for %s", query)
+			t.Errorf("Expecting %v, got %v", expecting, fmt.Sprint(parsed))
+		}
+	}
+}
+
+func TestParse_NestedArray(t *testing.T) {
+	tests := map[string]string{
+		"a[0][b]=c&a[1][b]=d": "[{\"b\":\"c\"} {\"b\":\"d\"}]",
+		"a=b&a[c]=d":          "[b {\"c\":\"d\"}]",
+	}
+	for query, expecting := range tests {
+		parsed, _ := Parse(query)
+		values := parsed.Get("a").(array.Array).Values()
+		if fmt.Sprint(values) != expecting {
+			t.Errorf("Parse(query) nested array does not match for %s", query)
+			t.Errorf("Expecting %v, got %v", expecting, fmt.Sprint(values))
+		}
+	}
+}
+
+func TestParseWithOptions(t *testing.T) {
+	options := DefaultOptions
+	options.AllowDots = true
+	options.Depth = 1
+	parsed, _ := ParseWithOptions("a.b=c&d[e][f]=g", options)
+	expecting := "{\"a\":{\"b\":\"c\"},\"d\":{\"e\":{\"[f]\":\"g\"}}}"
+	if fmt.Sprint(parsed) != expecting {
+		t.Error("ParseWithOptions(query, options) value does not match")
+		t.Errorf("Expecting %v, got %v", expecting, fmt.Sprint(parsed))
+		return
+	}
+	options = DefaultOptions
+	options.ParameterLimit = 2
+	parsed, _ = ParseWithOptions("a=1&b=2&c=3", options)
+	expecting = "{\"a\":\"1\",\"b\":\"2\"}"
+	if fmt.Sprint(parsed) != expecting {
+		t.Error("ParseWithOptions(query, options) parameter limit is not applied")
+		t.Errorf("Expecting %v, got %v", expecting, fmt.Sprint(parsed))
+		return
+	}
+	options.StrictLimits = true
+	if _, err := ParseWithOptions("a=1&b=2&c=3", options); err != ParameterLimitError {
+		t.Error("ParseWithOptions(query, options) does not return ParameterLimitError")
+		t.Errorf("Expecting %v, got %v", ParameterLimitError, err)
+		return
+	}
+	options = DefaultOptions
+	options.Depth = 1
+	options.StrictLimits = true
+	if _, err := ParseWithOptions("a[b][c]=d", options); err != DepthLimitError {
+		t.Error("ParseWithOptions(query, options) does not return DepthLimitError")
+		t.Errorf("Expecting %v, got %v", DepthLimitError, err)
+		return
+	}
+}
+
+func TestParseObject(t *testing.T) {
+	parsed, err := ParseObject("z[y]=1&a[]=x")
+	if err != nil {
+		t.Error("ParseObject(query) failed to parse query string")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	expecting := "{\"a\":[\"x\"],\"z\":{\"y\":\"1\"}}"
+	if fmt.Sprint(parsed) != expecting {
+		t.Error("ParseObject(query) value does not match")
+		t.Errorf("Expecting %v, got %v", expecting, fmt.Sprint(parsed))
+		return
+	}
+}
+
+func TestParseValues(t *testing.T) {
+	values := url.Values{}
+	values.Add("b[]", "1")
+	values.Add("b[]", "2")
+	values.Add("a[c]", "d e")
+	parsed, _ := ParseValues(values)
+	expecting := "{\"a\":{\"c\":\"d e\"},\"b\":[\"1\",\"2\"]}"
+	if fmt.Sprint(parsed) != expecting {
+		t.Error("ParseValues(values) value does not match")
+		t.Errorf("Expecting %v, got %v", expecting, fmt.Sprint(parsed))
+		return
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package qs
+
+import (
+	"errors"
+)
+
+var (
+	DepthLimitError = errors.New("the given query string has a key nested deeper than the depth limit")
+	NonMapTypeError = errors.New("the given parameter v is a non-map type, " +
+		"parameter v should be a Collection, an Object or a map")
+	ParameterLimitError = errors.New("the given query string has more parameters than the parameter limit")
+)
+
+// ArrayFormat defines how an array is written by the Stringify() function.
+type ArrayFormat int
+
+const (
+	// Indices writes an array as a[0]=b&a[1]=c.
+	Indices ArrayFormat = iota
+	// Brackets writes an array as a[]=b&a[]=c.
+	Brackets
+	// Repeat writes an array as a=b&a=c.
+	Repeat
+)
+
+// Options defines the options used to parse and stringify a query string.
+// Use DefaultOptions as the starting point of custom options.
+type Options struct {
+	// AllowDots enables the dot notation, so a.b=c is the same as a[b]=c.
+	AllowDots bool
+	// ArrayFormat is the format used to stringify arrays.
+	ArrayFormat ArrayFormat
+	// ArrayLimit is the highest index parsed as an array index. A key with
+	// a higher index, such as a[100]=b, is parsed as a map key instead.
+	ArrayLimit int
+	// Delimiter separates the parameters. It defaults to "&".
+	Delimiter string
+	// Depth is the maximum number of nested keys to parse. The rest of a
+	// deeper key is kept as a single literal key, such as "[d][e]".
+	Depth int
+	// EncodeValuesOnly leaves the keys unencoded when stringifying, so the
+	// brackets are written as they are.
+	EncodeValuesOnly bool
+	// ParameterLimit is the maximum number of parameters to parse. The
+	// parameters after the limit are ignored. Set it to zero to parse every
+	// parameter.
+	ParameterLimit int
+	// StrictLimits returns DepthLimitError or ParameterLimitError when a
+	// limit is exceeded, instead of silently applying the limit.
+	StrictLimits bool
+}
+
+// DefaultOptions defines the options used by the Parse() and Stringify()
+// functions.
+var DefaultOptions = Options{
+	ArrayFormat:    Indices,
+	ArrayLimit:     20,
+	Delimiter:      "&",
+	Depth:          5,
+	ParameterLimit: 1000,
+}
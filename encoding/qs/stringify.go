@@ -0,0 +1,198 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package qs
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/with-go/standard/array"
+	"github.com/with-go/standard/collection"
+	"github.com/with-go/standard/cycle"
+	"github.com/with-go/standard/object"
+)
+
+// The Stringify() function returns the query string of the given
+// Collection, Object or map using the DefaultOptions. If v is not one of
+// those types, it will returns NonMapTypeError.
+//
+// Nested maps are written as a[b]=c, and nested arrays are written based
+// on the ArrayFormat option. A nil value is written as an empty value. If v
+// holds a circular reference, it will returns a cycle.PathError.
+func Stringify(v interface{}) (string, error) {
+	return StringifyWithOptions(v, DefaultOptions)
+}
+
+// The StringifyWithOptions() function is the same as Stringify() function,
+// but uses the given options.
+func StringifyWithOptions(v interface{}, options Options) (string, error) {
+	parameters, err := flattenRoot(v, options)
+	if err != nil {
+		return "", err
+	}
+	delimiter := options.Delimiter
+	if delimiter == "" {
+		delimiter = "&"
+	}
+	parts := make([]string, len(parameters))
+	for index, parameter := range parameters {
+		key := parameter.key
+		if !options.EncodeValuesOnly {
+			key = escape(key)
+		}
+		parts[index] = key + "=" + escape(parameter.value)
+	}
+	return strings.Join(parts, delimiter), nil
+}
+
+// The Values() function is the same as Stringify() function, but returns
+// the parameters as url.Values. Because url.Values is a native map, the
+// parameter order is not kept.
+func Values(v interface{}) (url.Values, error) {
+	return ValuesWithOptions(v, DefaultOptions)
+}
+
+// The ValuesWithOptions() function is the same as Values() function, but
+// uses the given options.
+func ValuesWithOptions(v interface{}, options Options) (url.Values, error) {
+	parameters, err := flattenRoot(v, options)
+	if err != nil {
+		return nil, err
+	}
+	values := url.Values{}
+	for _, parameter := range parameters {
+		values.Add(parameter.key, parameter.value)
+	}
+	return values, nil
+}
+
+type parameter struct {
+	key   string
+	value string
+}
+
+func flattenRoot(v interface{}, options Options) ([]parameter, error) {
+	if _, ok := v.(*collection.Collection); !ok && reflect.ValueOf(v).Kind() != reflect.Map {
+		return nil, NonMapTypeError
+	}
+	if err := cycle.Check(v); err != nil {
+		return nil, err
+	}
+	var parameters []parameter
+	flatten("", v, options, &parameters)
+	return parameters, nil
+}
+
+func flatten(prefix string, value interface{}, options Options, parameters *[]parameter) {
+	join := func(key string) string {
+		if prefix == "" {
+			return key
+		}
+		if options.AllowDots {
+			return prefix + "." + key
+		}
+		return prefix + "[" + key + "]"
+	}
+	element := func(index int) string {
+		switch options.ArrayFormat {
+		case Brackets:
+			return prefix + "[]"
+		case Repeat:
+			return prefix
+		}
+		return prefix + "[" + strconv.Itoa(index) + "]"
+	}
+	switch value := value.(type) {
+	case nil:
+		*parameters = append(*parameters, parameter{prefix, ""})
+	case *collection.Collection:
+		if value == nil {
+			*parameters = append(*parameters, parameter{prefix, ""})
+			return
+		}
+		value.ForEach(func(key interface{}, child interface{}) {
+			flatten(join(collection.KeyString(key)), child, options, parameters)
+		})
+	case object.Object:
+		value.ForEach(func(key string, child interface{}) {
+			flatten(join(key), child, options, parameters)
+		})
+	case array.Array:
+		for index, child := range value {
+			flatten(element(index), child, options, parameters)
+		}
+	default:
+		reflection := reflect.ValueOf(value)
+		switch reflection.Kind() {
+		case reflect.Map:
+			keys := make([]string, 0, reflection.Len())
+			children := make(map[string]interface{})
+			for _, key := range reflection.MapKeys() {
+				name := fmt.Sprint(key.Interface())
+				keys = append(keys, name)
+				children[name] = reflection.MapIndex(key).Interface()
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				flatten(join(key), children[key], options, parameters)
+			}
+		case reflect.Slice, reflect.Array:
+			if reflection.Type().Elem().Kind() != reflect.Uint8 {
+				for index := 0; index < reflection.Len(); index++ {
+					flatten(element(index), reflection.Index(index).Interface(), options, parameters)
+				}
+				return
+			}
+			*parameters = append(*parameters, parameter{prefix, string(reflection.Bytes())})
+		default:
+			*parameters = append(*parameters, parameter{prefix, format(value)})
+		}
+	}
+}
+
+// The format() function formats a scalar value as a parameter value.
+func format(value interface{}) string {
+	reflection := reflect.ValueOf(value)
+	switch reflection.Kind() {
+	case reflect.String:
+		return reflection.String()
+	case reflect.Bool:
+		return strconv.FormatBool(reflection.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(reflection.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(reflection.Uint(), 10)
+	case reflect.Float32:
+		return strconv.FormatFloat(reflection.Float(), 'f', -1, 32)
+	case reflect.Float64:
+		return strconv.FormatFloat(reflection.Float(), 'f', -1, 64)
+	case reflect.Ptr, reflect.Interface:
+		if reflection.IsNil() {
+			return ""
+		}
+	}
+	return fmt.Sprint(value)
+}
+
+// The escape() function percent-encodes every byte of s except the
+// unreserved characters of RFC 3986, so a space is written as %20.
+func escape(s string) string {
+	var builder strings.Builder
+	for index := 0; index < len(s); index++ {
+		c := s[index]
+		if 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' ||
+			c == '-' || c == '_' || c == '.' || c == '~' {
+			builder.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&builder, "%%%02X", c)
+	}
+	return builder.String()
+}
@@ -0,0 +1,115 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package qs
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/with-go/standard/array"
+	"github.com/with-go/standard/collection"
+	"github.com/with-go/standard/cycle"
+	"github.com/with-go/standard/object"
+)
+
+func TestStringify(t *testing.T) {
+	query := collection.New().
+		Set("filter", collection.New().Set("status", "open").Set("owner", nil)).
+		Set("tags", array.New("a", "b c")).
+		Set("page", 2)
+	encoded, err := Stringify(query)
+	if err != nil {
+		t.Error("Stringify(v) failed to stringify")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	expecting := "filter%5Bstatus%5D=open&filter%5Bowner%5D=&tags%5B0%5D=a&tags%5B1%5D=b%20c&page=2"
+	if encoded != expecting {
+		t.Error("Stringify(v) query string does not match")
+		t.Errorf("Expecting %v, got %v", expecting, encoded)
+		return
+	}
+	if _, err := Stringify("a=b"); err != NonMapTypeError {
+		t.Error("Stringify(v) accepted a non-map type")
+		t.Errorf("Expecting %v, got %v", NonMapTypeError, err)
+		return
+	}
+}
+
+func TestStringify_circular(t *testing.T) {
+	circular := object.New()
+	tags := []interface{}{"a", nil}
+	tags[1] = circular
+	circular.Set("tags", tags)
+	_, err := Stringify(circular)
+	var pathError *cycle.PathError
+	if !errors.As(err, &pathError) || !reflect.DeepEqual(pathError.Path, []interface{}{"tags", 1}) {
+		t.Error("Stringify() error does not match")
+		t.Errorf("Expecting %v, got %v", "$.tags[1]", err)
+		return
+	}
+}
+
+func TestStringifyWithOptions(t *testing.T) {
+	query := object.New().
+		Set("tags", array.New("a", "b")).
+		Set("a", object.New().Set("b", true))
+	tests := map[ArrayFormat]string{
+		Indices:  "a[b]=true&tags[0]=a&tags[1]=b",
+		Brackets: "a[b]=true&tags[]=a&tags[]=b",
+		Repeat:   "a[b]=true&tags=a&tags=b",
+	}
+	for format, expecting := range tests {
+		options := DefaultOptions
+		options.ArrayFormat = format
+		options.EncodeValuesOnly = true
+		encoded, _ := StringifyWithOptions(query, options)
+		if encoded != expecting {
+			t.Error("StringifyWithOptions(v, options) query string does not match")
+			t.Errorf("Expecting %v, got %v", expecting, encoded)
+		}
+	}
+	options := DefaultOptions
+	options.AllowDots = true
+	options.Delimiter = ";"
+	encoded, _ := StringifyWithOptions(query, options)
+	expecting := "a.b=true;tags%5B0%5D=a;tags%5B1%5D=b"
+	if encoded != expecting {
+		t.Error("StringifyWithOptions(v, options) query string with dots does not match")
+		t.Errorf("Expecting %v, got %v", expecting, encoded)
+		return
+	}
+}
+
+func TestValues(t *testing.T) {
+	values, err := Values(map[string]interface{}{
+		"a": []string{"x", "y"},
+		"b": map[string]int{"c": 1},
+	})
+	if err != nil {
+		t.Error("Values(v) failed to encode")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	expecting := map[string][]string{"a[0]": {"x"}, "a[1]": {"y"}, "b[c]": {"1"}}
+	if !reflect.DeepEqual(map[string][]string(values), expecting) {
+		t.Error("Values(v) values do not match")
+		t.Errorf("Expecting %v, got %v", expecting, values)
+		return
+	}
+}
+
+func TestStringify_RoundTrip(t *testing.T) {
+	query := "a[0][b]=c&a[1][b]=d&e[f][g]=h&i=j%20k"
+	parsed, _ := Parse(query)
+	encoded, _ := StringifyWithOptions(parsed, Options{EncodeValuesOnly: true})
+	if encoded != query {
+		t.Error("Stringify(Parse(query)) does not round-trip")
+		t.Errorf("Expecting %v, got %v", query, encoded)
+		return
+	}
+}
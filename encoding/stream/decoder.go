@@ -0,0 +1,155 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package stream
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+
+	"github.com/with-go/standard/array"
+	"github.com/with-go/standard/collection"
+)
+
+var (
+	NonArrayError = errors.New("the given stream does not start with a JSON array")
+)
+
+// The NewDecoder() function creates a new Decoder that reads from r.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{decoder: json.NewDecoder(r)}
+}
+
+// Decoder defines a Decoder Type, which reads JSON values from an input
+// stream. JSON objects are decoded into Collections in the same key order
+// as the input, JSON arrays are decoded into Arrays, and JSON numbers are
+// decoded into float64 values, unless UseNumber() is called.
+type Decoder struct {
+	decoder *json.Decoder
+	started bool
+	ended   bool
+	// err holds the error that occurred while starting to read the array,
+	// since the opening token cannot be read again.
+	err error
+}
+
+// The Decode() function reads the next JSON value from the stream. If
+// there is no value left, it will returns io.EOF.
+func (decoder *Decoder) Decode() (interface{}, error) {
+	return decodeValue(decoder.decoder)
+}
+
+// The Err() function returns the error that occurred when More() function
+// started to read the top-level JSON array, or nil if there is none.
+func (decoder *Decoder) Err() error {
+	return decoder.err
+}
+
+// The More() function reports whether there is another element in the
+// top-level JSON array being read by the Next() function. If the stream
+// does not start with a JSON array, it returns false, and the error is
+// returned by the Err() and Next() functions.
+func (decoder *Decoder) More() bool {
+	if decoder.ended || decoder.err != nil {
+		return false
+	}
+	if !decoder.started {
+		if err := decoder.start(); err != nil {
+			return false
+		}
+	}
+	return decoder.decoder.More()
+}
+
+// The Next() function reads the next element of a top-level JSON array, so
+// a huge array can be processed one element at a time. If the stream does
+// not start with a JSON array, it will returns NonArrayError. After the
+// last element, it will returns io.EOF.
+func (decoder *Decoder) Next() (interface{}, error) {
+	if decoder.err != nil {
+		return nil, decoder.err
+	}
+	if decoder.ended {
+		return nil, io.EOF
+	}
+	if !decoder.started {
+		if err := decoder.start(); err != nil {
+			return nil, err
+		}
+	}
+	if !decoder.decoder.More() {
+		// Consume the closing bracket of the array.
+		if _, err := decoder.decoder.Token(); err != nil {
+			return nil, err
+		}
+		decoder.ended = true
+		return nil, io.EOF
+	}
+	return decodeValue(decoder.decoder)
+}
+
+// The UseNumber() function causes the Decoder to decode a JSON number into
+// a json.Number instead of a float64.
+func (decoder *Decoder) UseNumber() {
+	decoder.decoder.UseNumber()
+}
+
+// The start() function reads the opening bracket of the top-level JSON
+// array, and keeps the error if there is one.
+func (decoder *Decoder) start() error {
+	token, err := decoder.decoder.Token()
+	if err == nil && token != json.Delim('[') {
+		err = NonArrayError
+	}
+	if err != nil {
+		decoder.err = err
+		return err
+	}
+	decoder.started = true
+	return nil
+}
+
+// The decodeValue() function reads the next JSON value token by token, so
+// the key order of JSON objects is kept.
+func decodeValue(decoder *json.Decoder) (interface{}, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch token {
+	case json.Delim('{'):
+		value := collection.New()
+		for decoder.More() {
+			key, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+			element, err := decodeValue(decoder)
+			if err != nil {
+				return nil, err
+			}
+			value.Set(key.(string), element)
+		}
+		if _, err := decoder.Token(); err != nil {
+			return nil, err
+		}
+		return value, nil
+	case json.Delim('['):
+		value := array.New()
+		for decoder.More() {
+			element, err := decodeValue(decoder)
+			if err != nil {
+				return nil, err
+			}
+			value.PushInPlace(element)
+		}
+		if _, err := decoder.Token(); err != nil {
+			return nil, err
+		}
+		return value, nil
+	}
+	return token, nil
+}
@@ -0,0 +1,115 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package stream
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/with-go/standard/array"
+	"github.com/with-go/standard/collection"
+)
+
+func TestDecoder_Decode(t *testing.T) {
+	decoder := NewDecoder(strings.NewReader("{\"z\":1,\"a\":{\"y\":[true,null]}} \"next\""))
+	value, err := decoder.Decode()
+	if err != nil {
+		t.Error("decoder.Decode() failed to decode")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	document := value.(*collection.Collection)
+	if fmt.Sprint(document.Keys()) != "[z a]" {
+		t.Error("decoder.Decode() does not keep the key order")
+		t.Errorf("Expecting %v, got %v", "[z a]", document.Keys())
+		return
+	}
+	elements := document.Get("a").(*collection.Collection).Get("y").(array.Array)
+	if !elements.Equal(array.New(true, nil)) {
+		t.Error("decoder.Decode() nested array does not match")
+		t.Errorf("Expecting %v, got %v", array.New(true, nil), elements)
+		return
+	}
+	if value, _ := decoder.Decode(); value != "next" {
+		t.Error("decoder.Decode() second value does not match")
+		t.Errorf("Expecting %v, got %v", "next", value)
+		return
+	}
+	if _, err := decoder.Decode(); err != io.EOF {
+		t.Error("decoder.Decode() does not return io.EOF at the end")
+		t.Errorf("Expecting %v, got %v", io.EOF, err)
+		return
+	}
+}
+
+func TestDecoder_Next(t *testing.T) {
+	decoder := NewDecoder(strings.NewReader("[{\"b\":1,\"a\":2}, 3, [4]]"))
+	decoder.UseNumber()
+	var values []string
+	for decoder.More() {
+		value, err := decoder.Next()
+		if err != nil {
+			t.Error("decoder.Next() failed to decode")
+			t.Errorf("Reason: %s", err.Error())
+			return
+		}
+		values = append(values, fmt.Sprint(value))
+	}
+	expecting := "[{\"b\":1,\"a\":2} 3 [4]]"
+	if fmt.Sprint(values) != expecting {
+		t.Error("decoder.Next() elements do not match")
+		t.Errorf("Expecting %v, got %v", expecting, values)
+		return
+	}
+	if _, err := decoder.Next(); err != io.EOF {
+		t.Error("decoder.Next() does not return io.EOF at the end")
+		t.Errorf("Expecting %v, got %v", io.EOF, err)
+		return
+	}
+	decoder = NewDecoder(strings.NewReader("[1.5]"))
+	decoder.UseNumber()
+	if value, _ := decoder.Next(); value != json.Number("1.5") {
+		t.Error("decoder.Next() does not decode a json.Number")
+		t.Errorf("Expecting %v, got %v", json.Number("1.5"), value)
+		return
+	}
+	if _, err := NewDecoder(strings.NewReader("{}")).Next(); err != NonArrayError {
+		t.Error("decoder.Next() does not return NonArrayError")
+		t.Errorf("Expecting %v, got %v", NonArrayError, err)
+		return
+	}
+}
+
+func TestDecoder_More_malformed(t *testing.T) {
+	for _, input := range []string{"{\"a\":1}", "}", "@", ""} {
+		decoder := NewDecoder(strings.NewReader(input))
+		if decoder.More() {
+			t.Error("decoder.More() reports an element for " + input)
+			return
+		}
+		if decoder.Err() == nil {
+			t.Error("decoder.Err() does not report an error for " + input)
+			return
+		}
+		if _, err := decoder.Next(); err != decoder.Err() {
+			t.Error("decoder.Next() error does not match for " + input)
+			t.Errorf("Expecting %v, got %v", decoder.Err(), err)
+			return
+		}
+	}
+	decoder := NewDecoder(strings.NewReader("[1]"))
+	for decoder.More() {
+		decoder.Next()
+	}
+	if decoder.Err() != nil {
+		t.Error("decoder.Err() reports an error for a valid array")
+		t.Errorf("Expecting %v, got %v", nil, decoder.Err())
+		return
+	}
+}
@@ -0,0 +1,18 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+/*
+The Stream reads and writes JSON incrementally using an io.Reader or an
+io.Writer, so a large dataset never has to be held as a single string.
+
+An Encoder writes an Array, an Object or a Collection element by element.
+A Decoder reads JSON values, or reads the elements of a top-level JSON array
+one at a time. JSON objects are decoded into Collections that keep the key
+order of the input, and JSON arrays are decoded into Arrays.
+
+A LineReader and a LineWriter read and write newline-delimited JSON
+(NDJSON), where every line holds a single JSON object.
+*/
+package stream
@@ -0,0 +1,159 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package stream
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+
+	"github.com/with-go/standard/array"
+	"github.com/with-go/standard/collection"
+	"github.com/with-go/standard/cycle"
+	"github.com/with-go/standard/object"
+)
+
+// The NewEncoder() function creates a new Encoder that writes to w.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{bufio.NewWriter(w)}
+}
+
+// Encoder defines an Encoder Type, which writes JSON values to an output
+// stream.
+type Encoder struct {
+	writer *bufio.Writer
+}
+
+// The Encode() function writes the JSON encoding of v to the stream,
+// followed by a newline character. Collections are written in insertion
+// order, while the keys of Objects and native maps are sorted
+// alphabetically. Any other value is written using the "encoding/json"
+// package.
+//
+// The elements are written one by one through a buffer, so the JSON
+// encoding of v is never held in memory as a whole. If v holds a circular
+// reference, it will returns a cycle.PathError before writing anything.
+func (encoder *Encoder) Encode(v interface{}) error {
+	if err := cycle.Check(v); err != nil {
+		return err
+	}
+	if err := encode(encoder.writer, v); err != nil {
+		return err
+	}
+	if err := encoder.writer.WriteByte('\n'); err != nil {
+		return err
+	}
+	return encoder.writer.Flush()
+}
+
+func encode(writer *bufio.Writer, v interface{}) error {
+	switch v := v.(type) {
+	case nil:
+		_, err := writer.WriteString("null")
+		return err
+	case *collection.Collection:
+		if v == nil {
+			_, err := writer.WriteString("null")
+			return err
+		}
+		keys := make([]string, 0, v.Length())
+		for _, key := range v.Keys() {
+			keys = append(keys, collection.KeyString(key))
+		}
+		return encodeMembers(writer, keys, v.Values())
+	case object.Object:
+		return encodeMembers(writer, v.Keys(), v.Values())
+	case array.Array:
+		return encodeElements(writer, reflect.ValueOf(v))
+	case json.Marshaler:
+		return encodeValue(writer, v)
+	}
+	reflection := reflect.ValueOf(v)
+	switch reflection.Kind() {
+	case reflect.Map:
+		if reflection.IsNil() {
+			_, err := writer.WriteString("null")
+			return err
+		}
+		keys := make([]string, 0, reflection.Len())
+		members := make(map[string]interface{})
+		for _, key := range reflection.MapKeys() {
+			name := fmt.Sprint(key.Interface())
+			keys = append(keys, name)
+			members[name] = reflection.MapIndex(key).Interface()
+		}
+		sort.Strings(keys)
+		values := make([]interface{}, len(keys))
+		for index, key := range keys {
+			values[index] = members[key]
+		}
+		return encodeMembers(writer, keys, values)
+	case reflect.Slice:
+		if reflection.IsNil() {
+			_, err := writer.WriteString("null")
+			return err
+		}
+		if reflection.Type().Elem().Kind() == reflect.Uint8 {
+			return encodeValue(writer, v)
+		}
+		return encodeElements(writer, reflection)
+	case reflect.Array:
+		return encodeElements(writer, reflection)
+	}
+	return encodeValue(writer, v)
+}
+
+func encodeMembers(writer *bufio.Writer, keys []string, values []interface{}) error {
+	if err := writer.WriteByte('{'); err != nil {
+		return err
+	}
+	for index, key := range keys {
+		if index != 0 {
+			if err := writer.WriteByte(','); err != nil {
+				return err
+			}
+		}
+		if err := encodeValue(writer, key); err != nil {
+			return err
+		}
+		if err := writer.WriteByte(':'); err != nil {
+			return err
+		}
+		if err := encode(writer, values[index]); err != nil {
+			return err
+		}
+	}
+	return writer.WriteByte('}')
+}
+
+func encodeElements(writer *bufio.Writer, reflection reflect.Value) error {
+	if err := writer.WriteByte('['); err != nil {
+		return err
+	}
+	for index := 0; index < reflection.Len(); index++ {
+		if index != 0 {
+			if err := writer.WriteByte(','); err != nil {
+				return err
+			}
+		}
+		if err := encode(writer, reflection.Index(index).Interface()); err != nil {
+			return err
+		}
+	}
+	return writer.WriteByte(']')
+}
+
+func encodeValue(writer *bufio.Writer, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = writer.Write(data)
+	return err
+}
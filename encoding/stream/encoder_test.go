@@ -0,0 +1,61 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package stream
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/with-go/standard/array"
+	"github.com/with-go/standard/collection"
+	"github.com/with-go/standard/cycle"
+	"github.com/with-go/standard/object"
+)
+
+func TestEncoder_Encode(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	encoder := NewEncoder(buffer)
+	value := array.New(
+		collection.New().
+			Set("z", "quote \" and <tag>").
+			Set("a", array.New(1, 2.5, nil)).
+			Set("child", collection.New().Set("y", true)),
+		object.New().Set("b", 1).Set("a", collection.New()),
+		map[string]interface{}{"d": []string{"x"}, "c": nil},
+		[]byte("hi"))
+	if err := encoder.Encode(value); err != nil {
+		t.Error("encoder.Encode(v) failed to encode")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	if err := encoder.Encode(collection.New().Set("next", 1)); err != nil {
+		t.Error("encoder.Encode(v) failed to encode")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	expecting := "[{\"z\":\"quote \\\" and \\u003ctag\\u003e\",\"a\":[1,2.5,null],\"child\":{\"y\":true}}," +
+		"{\"a\":{},\"b\":1},{\"c\":null,\"d\":[\"x\"]},\"aGk=\"]\n{\"next\":1}\n"
+	if buffer.String() != expecting {
+		t.Error("encoder.Encode(v) JSON does not match")
+		t.Errorf("Expecting %v, got %v", expecting, buffer.String())
+		return
+	}
+}
+
+func TestEncoder_Encode_circular(t *testing.T) {
+	circular := object.New()
+	circular.Set("a", array.New(1, object.New().Set("b", circular)))
+	buffer := &bytes.Buffer{}
+	err := NewEncoder(buffer).Encode(circular)
+	var pathError *cycle.PathError
+	if !errors.As(err, &pathError) || !reflect.DeepEqual(pathError.Path, []interface{}{"a", 1, "b"}) || buffer.Len() != 0 {
+		t.Error("encoder.Encode() error does not match")
+		t.Errorf("Expecting %v, got %v", "$.a[1].b", err)
+		return
+	}
+}
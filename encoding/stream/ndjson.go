@@ -0,0 +1,107 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package stream
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+
+	"github.com/with-go/standard/collection"
+)
+
+var (
+	NonObjectLineError = errors.New("the given line is not a JSON object, " +
+		"each line should hold a single JSON object")
+)
+
+// The NewLineReader() function creates a new LineReader that reads
+// newline-delimited JSON from r.
+func NewLineReader(r io.Reader) *LineReader {
+	return &LineReader{reader: bufio.NewReader(r)}
+}
+
+// LineReader defines a LineReader Type, which reads one Collection per
+// line of newline-delimited JSON. Only a single line is held in memory at
+// a time, so it is suitable for very large files.
+type LineReader struct {
+	reader    *bufio.Reader
+	line      int
+	useNumber bool
+}
+
+// The Line() function returns the number of the last line read, starting
+// from 1. It is useful to locate the line that causes an error.
+func (reader *LineReader) Line() int {
+	return reader.line
+}
+
+// The Read() function reads the next non-empty line and returns it as a
+// Collection in the same key order as the line. If the line is not a JSON
+// object, it will returns NonObjectLineError. If there is no line left, it
+// will returns io.EOF.
+func (reader *LineReader) Read() (*collection.Collection, error) {
+	for {
+		line, err := reader.reader.ReadBytes('\n')
+		if len(line) == 0 && err != nil {
+			return nil, err
+		}
+		reader.line++
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		decoder := json.NewDecoder(bytes.NewReader(line))
+		if reader.useNumber {
+			decoder.UseNumber()
+		}
+		value, decodeErr := decodeValue(decoder)
+		if decodeErr != nil {
+			return nil, decodeErr
+		}
+		// The line has to end after the object. More() function of the
+		// "encoding/json" package does not report a stray ] or }.
+		row, ok := value.(*collection.Collection)
+		if _, trailingErr := decoder.Token(); !ok || trailingErr != io.EOF {
+			return nil, NonObjectLineError
+		}
+		return row, nil
+	}
+}
+
+// The UseNumber() function causes the LineReader to decode a JSON number
+// into a json.Number instead of a float64.
+func (reader *LineReader) UseNumber() {
+	reader.useNumber = true
+}
+
+// The NewLineWriter() function creates a new LineWriter that writes
+// newline-delimited JSON to w.
+func NewLineWriter(w io.Writer) *LineWriter {
+	return &LineWriter{NewEncoder(w)}
+}
+
+// LineWriter defines a LineWriter Type, which writes one JSON object per
+// line of newline-delimited JSON.
+type LineWriter struct {
+	encoder *Encoder
+}
+
+// The Write() function writes the given Collection, Object or map as a
+// single line. If v is not one of those types, it will returns
+// NonObjectLineError.
+func (writer *LineWriter) Write(v interface{}) error {
+	if _, ok := v.(*collection.Collection); !ok && reflect.ValueOf(v).Kind() != reflect.Map {
+		return NonObjectLineError
+	}
+	return writer.encoder.Encode(v)
+}
@@ -0,0 +1,76 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package stream
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/with-go/standard/collection"
+	"github.com/with-go/standard/object"
+)
+
+func TestLineReader_Read(t *testing.T) {
+	reader := NewLineReader(strings.NewReader("{\"b\":1,\"a\":\"x\"}\n\n{\"c\":[1]}\r\n[1]\n{\"last\":true}"))
+	var rows []string
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err == NonObjectLineError {
+			if reader.Line() != 4 {
+				t.Error("reader.Line() does not match the failing line")
+				t.Errorf("Expecting %v, got %v", 4, reader.Line())
+				return
+			}
+			continue
+		}
+		if err != nil {
+			t.Error("reader.Read() failed to read a line")
+			t.Errorf("Reason: %s", err.Error())
+			return
+		}
+		rows = append(rows, fmt.Sprint(row))
+	}
+	expecting := "[{\"b\":1,\"a\":\"x\"} {\"c\":[1]} {\"last\":true}]"
+	if fmt.Sprint(rows) != expecting {
+		t.Error("reader.Read() rows do not match")
+		t.Errorf("Expecting %v, got %v", expecting, rows)
+		return
+	}
+}
+
+func TestLineReader_Read_trailing(t *testing.T) {
+	for _, line := range []string{"{\"a\":1}]", "{\"a\":1}}", "{\"a\":1} 2", "{\"a\":1}{}"} {
+		if _, err := NewLineReader(strings.NewReader(line)).Read(); err != NonObjectLineError {
+			t.Error("reader.Read() does not return NonObjectLineError for " + line)
+			t.Errorf("Expecting %v, got %v", NonObjectLineError, err)
+			return
+		}
+	}
+}
+
+func TestLineWriter_Write(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	writer := NewLineWriter(buffer)
+	_ = writer.Write(collection.New().Set("b", 1).Set("a", "x"))
+	_ = writer.Write(object.New().Set("d", nil).Set("c", 2))
+	if err := writer.Write("text"); err != NonObjectLineError {
+		t.Error("writer.Write(v) does not return NonObjectLineError")
+		t.Errorf("Expecting %v, got %v", NonObjectLineError, err)
+		return
+	}
+	expecting := "{\"b\":1,\"a\":\"x\"}\n{\"c\":2,\"d\":null}\n"
+	if buffer.String() != expecting {
+		t.Error("writer.Write(v) NDJSON does not match")
+		t.Errorf("Expecting %v, got %v", expecting, buffer.String())
+		return
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package xml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+
+	"github.com/with-go/standard/array"
+	"github.com/with-go/standard/collection"
+)
+
+// The Unmarshal() function parses the given XML document using the
+// DefaultOptions, and returns a Collection that holds the root element.
+// If the document has more than one root element, it will returns
+// RootElementError. See "xml" package documentation for more information.
+func Unmarshal(data []byte) (*collection.Collection, error) {
+	return UnmarshalWithOptions(data, DefaultOptions)
+}
+
+// The UnmarshalWithOptions() function is the same as Unmarshal() function,
+// but uses the given options.
+func UnmarshalWithOptions(data []byte, options Options) (*collection.Collection, error) {
+	return Decode(bytes.NewReader(data), options)
+}
+
+// The Decode() function is the same as UnmarshalWithOptions() function, but
+// reads the XML document from r.
+func Decode(r io.Reader, options Options) (*collection.Collection, error) {
+	decoder := xml.NewDecoder(r)
+	root := collection.New()
+	var stack []*element
+	for {
+		// RawToken() keeps the namespace prefixes as they are written, so
+		// the document can be written back with the same names.
+		token, err := decoder.RawToken()
+		if err == io.EOF {
+			if len(stack) != 0 {
+				return nil, io.ErrUnexpectedEOF
+			}
+			return root, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch token := token.(type) {
+		case xml.StartElement:
+			if len(stack) != 0 {
+				stack[len(stack)-1].endText()
+			} else if root.Length() != 0 {
+				return nil, RootElementError
+			}
+			current := &element{name: nameOf(token.Name), children: collection.New()}
+			for _, attribute := range token.Attr {
+				current.children.Set(options.AttributePrefix+nameOf(attribute.Name), attribute.Value)
+			}
+			stack = append(stack, current)
+		case xml.CharData:
+			if len(stack) != 0 {
+				stack[len(stack)-1].text.Write(token)
+			}
+		case xml.EndElement:
+			if len(stack) == 0 || stack[len(stack)-1].name != nameOf(token.Name) {
+				return nil, MismatchedElementError
+			}
+			current := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			current.endText()
+			if len(stack) == 0 {
+				appendChild(root, current.name, current.value(options))
+				continue
+			}
+			parent := stack[len(stack)-1]
+			appendChild(parent.children, current.name, current.value(options))
+			parent.order = append(parent.order, current.name)
+		}
+	}
+}
+
+// element is an XML element being decoded.
+type element struct {
+	name     string
+	children *collection.Collection
+	// text holds the text since the last child element.
+	text strings.Builder
+	// texts holds the text between the child elements that is not blank.
+	texts []string
+	// order holds the names of the child elements in document order, and
+	// an empty name in place of each of the texts.
+	order []string
+}
+
+// The endText() function ends the text since the last child element, when
+// a child element starts or the element ends.
+func (element *element) endText() {
+	if text := element.text.String(); strings.TrimSpace(text) != "" {
+		element.texts = append(element.texts, text)
+		element.order = append(element.order, "")
+	}
+	element.text.Reset()
+}
+
+// The value() function returns the value of the element once it ends. The
+// text of an element without child elements is trimmed. The text of an
+// element with child elements is mixed content, which is kept as it is.
+func (element *element) value(options Options) interface{} {
+	if len(element.order) == len(element.texts) {
+		text := strings.TrimSpace(strings.Join(element.texts, ""))
+		if element.children.Length() == 0 {
+			if text == "" {
+				return nil
+			}
+			return text
+		}
+		if text != "" {
+			element.children.Set(options.TextKey, text)
+		}
+		return element.children
+	}
+	switch len(element.texts) {
+	case 0:
+	case 1:
+		element.children.Set(options.TextKey, element.texts[0])
+	default:
+		texts := make(array.Array, len(element.texts))
+		for index, text := range element.texts {
+			texts[index] = text
+		}
+		element.children.Set(options.TextKey, texts)
+	}
+	if options.OrderKey != "" && !element.isInWrittenOrder() {
+		order := make(array.Array, len(element.order))
+		for index, name := range element.order {
+			if name == "" {
+				name = options.TextKey
+			}
+			order[index] = name
+		}
+		element.children.Set(options.OrderKey, order)
+	}
+	return element.children
+}
+
+// The isInWrittenOrder() function determines whether the child elements
+// and the texts are written back in document order without an OrderKey,
+// which writes the child elements grouped by name in order of first
+// appearance, followed by the texts.
+func (element *element) isInWrittenOrder() bool {
+	written := []string{}
+	counts := make(map[string]int)
+	for _, name := range element.order {
+		if name != "" {
+			if counts[name] == 0 {
+				written = append(written, name)
+			}
+			counts[name]++
+		}
+	}
+	index := 0
+	for _, name := range written {
+		for count := 0; count < counts[name]; count++ {
+			if element.order[index] != name {
+				return false
+			}
+			index++
+		}
+	}
+	return index+len(element.texts) == len(element.order)
+}
+
+// The appendChild() function stores a child element into its parent. A
+// repeated child element turns into an Array.
+func appendChild(parent *collection.Collection, name string, value interface{}) {
+	if !parent.Has(name) {
+		parent.Set(name, value)
+		return
+	}
+	if existing, ok := parent.Get(name).(array.Array); ok {
+		existing.PushInPlace(value)
+		parent.Set(name, existing)
+		return
+	}
+	parent.Set(name, array.New(parent.Get(name), value))
+}
+
+func nameOf(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return name.Space + ":" + name.Local
+}
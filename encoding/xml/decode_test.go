@@ -0,0 +1,119 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package xml
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/with-go/standard/array"
+	"github.com/with-go/standard/collection"
+)
+
+var testXml = `<?xml version="1.0"?>
+<book id="7" lang="en">
+	<!-- a comment -->
+	<title>Go &amp; Standard</title>
+	<tag>a</tag>
+	<tag>b</tag>
+	<price currency="USD">9.5</price>
+	<draft/>
+</book>`
+
+func TestUnmarshal(t *testing.T) {
+	document, err := Unmarshal([]byte(testXml))
+	if err != nil {
+		t.Error("Unmarshal(data) failed to parse XML")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	book := document.Get("book").(*collection.Collection)
+	keys := "[@id @lang title tag price draft]"
+	if fmt.Sprint(book.Keys()) != keys {
+		t.Error("Unmarshal(data) element order does not match")
+		t.Errorf("Expecting %v, got %v", keys, book.Keys())
+		return
+	}
+	if book.Get("title") != "Go & Standard" || book.Get("draft") != nil {
+		t.Error("Unmarshal(data) text values do not match")
+		t.Errorf("Expecting %v, got %v", "Go & Standard", book.Get("title"))
+		return
+	}
+	if !book.Get("tag").(array.Array).Equal(array.New("a", "b")) {
+		t.Error("Unmarshal(data) repeated elements are not an Array")
+		t.Errorf("Expecting %v, got %v", array.New("a", "b"), book.Get("tag"))
+		return
+	}
+	price := "{\"@currency\":\"USD\",\"#text\":\"9.5\"}"
+	if fmt.Sprint(book.Get("price")) != price {
+		t.Error("Unmarshal(data) element with attributes does not match")
+		t.Errorf("Expecting %v, got %v", price, book.Get("price"))
+		return
+	}
+}
+
+func TestUnmarshalWithOptions(t *testing.T) {
+	options := DefaultOptions
+	options.AttributePrefix = "-"
+	options.TextKey = "_"
+	options.OrderKey = "~"
+	document, _ := UnmarshalWithOptions([]byte(`<x:a xmlns:x="urn:x" x:b="c">d<e/></x:a>`), options)
+	expecting := "{\"x:a\":{\"-xmlns:x\":\"urn:x\",\"-x:b\":\"c\",\"e\":<nil>,\"_\":\"d\",\"~\":[\"_\",\"e\"]}}"
+	if fmt.Sprint(document) != expecting {
+		t.Error("UnmarshalWithOptions(data, options) value does not match")
+		t.Errorf("Expecting %v, got %v", expecting, document)
+		return
+	}
+}
+
+func TestUnmarshal_order(t *testing.T) {
+	document, _ := Unmarshal([]byte(`<r><a>1</a><b/><a>2</a></r>`))
+	expecting := "{\"r\":{\"a\":[\"1\",\"2\"],\"b\":<nil>,\"#order\":[\"a\",\"b\",\"a\"]}}"
+	if fmt.Sprint(document) != expecting {
+		t.Error("Unmarshal(data) interleaved elements do not match")
+		t.Errorf("Expecting %v, got %v", expecting, document)
+		return
+	}
+	document, _ = Unmarshal([]byte(`<p>Hello <b>world</b>, and <i>you</i>!</p>`))
+	expecting = "{\"p\":{\"b\":\"world\",\"i\":\"you\",\"#text\":[\"Hello \",\", and \",\"!\"]," +
+		"\"#order\":[\"#text\",\"b\",\"#text\",\"i\",\"#text\"]}}"
+	if fmt.Sprint(document) != expecting {
+		t.Error("Unmarshal(data) mixed content does not match")
+		t.Errorf("Expecting %v, got %v", expecting, document)
+		return
+	}
+	options := DefaultOptions
+	options.OrderKey = ""
+	document, _ = UnmarshalWithOptions([]byte(`<r><a/><b/><a/></r>`), options)
+	expecting = "{\"r\":{\"a\":[null,null],\"b\":<nil>}}"
+	if fmt.Sprint(document) != expecting {
+		t.Error("UnmarshalWithOptions(data, options) value without OrderKey does not match")
+		t.Errorf("Expecting %v, got %v", expecting, document)
+		return
+	}
+}
+
+func TestDecode(t *testing.T) {
+	if _, err := Decode(strings.NewReader("<a><b></a>"), DefaultOptions); err != MismatchedElementError {
+		t.Error("Decode(r, options) does not return MismatchedElementError")
+		t.Errorf("Expecting %v, got %v", MismatchedElementError, err)
+		return
+	}
+	if _, err := Decode(strings.NewReader("<a><b></b>"), DefaultOptions); err != io.ErrUnexpectedEOF {
+		t.Error("Decode(r, options) does not return io.ErrUnexpectedEOF")
+		t.Errorf("Expecting %v, got %v", io.ErrUnexpectedEOF, err)
+		return
+	}
+	for _, document := range []string{"<a></a><b></b>", "<a/>\n<a/>"} {
+		if _, err := Decode(strings.NewReader(document), DefaultOptions); err != RootElementError {
+			t.Error("Decode(r, options) does not return RootElementError")
+			t.Errorf("Expecting %v, got %v", RootElementError, err)
+			return
+		}
+	}
+}
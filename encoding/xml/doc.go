@@ -0,0 +1,42 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+/*
+The XML converts XML documents into Collections and back. It is built on top
+of the "encoding/xml" package. A document such as
+
+	<book id="7"><title>Go</title><tag>a</tag><tag>b</tag></book>
+
+is converted into a Collection equal to
+{"book":{"@id":"7","title":"Go","tag":["a","b"]}}, where:
+
+  - Attributes are stored with a prefixed key, such as "@id".
+  - Elements are stored under their name, in order of first appearance.
+  - Repeated elements with the same name are stored as an Array.
+  - The text of an element without child elements is trimmed. It is stored
+    under the "#text" key if the element has attributes, and as the value
+    itself otherwise. An empty element is stored as nil.
+  - The text of an element with child elements is mixed content, which is
+    kept as it is under the "#text" key, as an Array if the text is split
+    by child elements. Blank text between child elements is dropped.
+
+Elements grouped by name are written back grouped, with the text after
+them. When this would change the document order, such as for
+<a/><b/><a/> or for text before a child element, the element also holds
+an Array of the names of its children in document order under the
+"#order" key, where each text is named "#text":
+
+	<p>Hi <b>you</b>!</p>
+
+is converted into {"p":{"b":"you","#text":["Hi ","!"],
+"#order":["#text","b","#text"]}}, and written back the same way.
+
+Names keep their namespace prefix, such as "ns:title", so a document is
+written back the same way it was read, except for comments, processing
+instructions, blank text between elements and whitespace around the text
+of an element without child elements. Mixed content is written back as it
+was read only if Options.Indent is empty.
+*/
+package xml
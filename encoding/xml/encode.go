@@ -0,0 +1,209 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package xml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/with-go/standard/array"
+	"github.com/with-go/standard/collection"
+	"github.com/with-go/standard/cycle"
+	"github.com/with-go/standard/object"
+)
+
+// The Marshal() function returns the XML document of the given Collection,
+// Object or map using the DefaultOptions. The given value should have
+// exactly one element, which is written as the root element. Otherwise, it
+// will returns RootElementError. If v holds a circular reference, it will
+// returns a cycle.PathError.
+func Marshal(v interface{}) ([]byte, error) {
+	return MarshalWithOptions(v, DefaultOptions)
+}
+
+// The MarshalWithOptions() function is the same as Marshal() function, but
+// uses the given options.
+func MarshalWithOptions(v interface{}, options Options) ([]byte, error) {
+	buffer := &bytes.Buffer{}
+	if err := Encode(buffer, v, options); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// The Encode() function is the same as MarshalWithOptions() function, but
+// writes the XML document to w.
+func Encode(w io.Writer, v interface{}, options Options) error {
+	names, values, ok := membersOf(v)
+	if !ok {
+		return NonMapTypeError
+	}
+	if len(names) != 1 {
+		return RootElementError
+	}
+	if err := cycle.Check(v); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", options.Indent)
+	if err := encode(encoder, names[0], values[0], options); err != nil {
+		return err
+	}
+	return encoder.Flush()
+}
+
+func encode(encoder *xml.Encoder, name string, value interface{}, options Options) error {
+	if values, ok := elementsOf(value); ok {
+		for _, element := range values {
+			if err := encode(encoder, name, element, options); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	start := xml.StartElement{Name: xml.Name{Local: name}}
+	names, values, isMap := membersOf(value)
+	for index, name := range names {
+		if isAttribute(name, options) {
+			start.Attr = append(start.Attr, xml.Attr{
+				Name:  xml.Name{Local: strings.TrimPrefix(name, options.AttributePrefix)},
+				Value: format(values[index]),
+			})
+		}
+	}
+	if err := encoder.EncodeToken(start); err != nil {
+		return err
+	}
+	if isMap {
+		if err := encodeChildren(encoder, names, values, options); err != nil {
+			return err
+		}
+	} else if value != nil {
+		if err := encoder.EncodeToken(xml.CharData(format(value))); err != nil {
+			return err
+		}
+	}
+	return encoder.EncodeToken(start.End())
+}
+
+// The encodeChildren() function writes the child elements and the texts of
+// an element. If the element has an OrderKey, they are written in that
+// order first. Any other child elements and texts are written grouped by
+// name, in the order of the keys.
+func encodeChildren(encoder *xml.Encoder, names []string, values []interface{}, options Options) error {
+	var order []interface{}
+	var written []string
+	items := make(map[string][]interface{})
+	for index, name := range names {
+		switch {
+		case options.OrderKey != "" && name == options.OrderKey:
+			order, _ = elementsOf(values[index])
+		case isAttribute(name, options):
+		default:
+			written = append(written, name)
+			items[name] = []interface{}{values[index]}
+			if elements, ok := elementsOf(values[index]); ok {
+				items[name] = elements
+			}
+		}
+	}
+	for _, entry := range order {
+		name := format(entry)
+		if len(items[name]) == 0 {
+			continue
+		}
+		if err := encodeChild(encoder, name, items[name][0], options); err != nil {
+			return err
+		}
+		items[name] = items[name][1:]
+	}
+	for _, name := range written {
+		for _, item := range items[name] {
+			if err := encodeChild(encoder, name, item, options); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// The encodeChild() function writes a single child element, or a text if
+// the name is the TextKey.
+func encodeChild(encoder *xml.Encoder, name string, value interface{}, options Options) error {
+	if name == options.TextKey {
+		return encoder.EncodeToken(xml.CharData(format(value)))
+	}
+	return encode(encoder, name, value, options)
+}
+
+func isAttribute(name string, options Options) bool {
+	return options.AttributePrefix != "" && strings.HasPrefix(name, options.AttributePrefix) &&
+		!(options.OrderKey != "" && name == options.OrderKey)
+}
+
+// The membersOf() function returns the keys and values of a Collection, an
+// Object or a map, in the order they are written.
+func membersOf(value interface{}) ([]string, []interface{}, bool) {
+	switch value := value.(type) {
+	case *collection.Collection:
+		if value == nil {
+			return nil, nil, false
+		}
+		names := make([]string, 0, value.Length())
+		for _, key := range value.Keys() {
+			names = append(names, collection.KeyString(key))
+		}
+		return names, value.Values(), true
+	case object.Object:
+		return value.Keys(), value.Values(), true
+	}
+	reflection := reflect.ValueOf(value)
+	if reflection.Kind() != reflect.Map {
+		return nil, nil, false
+	}
+	names := make([]string, 0, reflection.Len())
+	members := make(map[string]interface{})
+	for _, key := range reflection.MapKeys() {
+		name := fmt.Sprint(key.Interface())
+		names = append(names, name)
+		members[name] = reflection.MapIndex(key).Interface()
+	}
+	sort.Strings(names)
+	values := make([]interface{}, len(names))
+	for index, name := range names {
+		values[index] = members[name]
+	}
+	return names, values, true
+}
+
+// The elementsOf() function returns the elements of an Array or a slice,
+// which are written as repeated elements.
+func elementsOf(value interface{}) ([]interface{}, bool) {
+	if values, ok := value.(array.Array); ok {
+		return values, true
+	}
+	reflection := reflect.ValueOf(value)
+	if reflection.Kind() != reflect.Slice || reflection.Type().Elem().Kind() == reflect.Uint8 {
+		return nil, false
+	}
+	values, _ := array.NewFromSlice(value)
+	return values, true
+}
+
+func format(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+	if raw, ok := value.([]byte); ok {
+		return string(raw)
+	}
+	return fmt.Sprint(value)
+}
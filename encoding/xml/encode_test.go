@@ -0,0 +1,138 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package xml
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/with-go/standard/array"
+	"github.com/with-go/standard/collection"
+	"github.com/with-go/standard/cycle"
+	"github.com/with-go/standard/object"
+)
+
+func TestMarshal(t *testing.T) {
+	document := collection.New().Set("book", collection.New().
+		Set("@id", 7).
+		Set("title", "Go & Standard").
+		Set("tag", array.New("a", "b")).
+		Set("price", object.New().Set("@currency", "USD").Set("#text", 9.5)).
+		Set("draft", nil))
+	data, err := Marshal(document)
+	if err != nil {
+		t.Error("Marshal(v) failed to write XML")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	expecting := "<book id=\"7\"><title>Go &amp; Standard</title><tag>a</tag><tag>b</tag>" +
+		"<price currency=\"USD\">9.5</price><draft></draft></book>"
+	if string(data) != expecting {
+		t.Error("Marshal(v) XML does not match")
+		t.Errorf("Expecting %v, got %v", expecting, string(data))
+		return
+	}
+	if _, err := Marshal(collection.New().Set("a", 1).Set("b", 2)); err != RootElementError {
+		t.Error("Marshal(v) does not return RootElementError")
+		t.Errorf("Expecting %v, got %v", RootElementError, err)
+		return
+	}
+	if _, err := Marshal(array.New()); err != NonMapTypeError {
+		t.Error("Marshal(v) does not return NonMapTypeError")
+		t.Errorf("Expecting %v, got %v", NonMapTypeError, err)
+		return
+	}
+}
+
+func TestMarshal_circular(t *testing.T) {
+	child := object.New()
+	circular := object.New().Set("a", child)
+	child.Set("b", array.New(object.New().Set("c", circular)))
+	_, err := Marshal(circular)
+	var pathError *cycle.PathError
+	if !errors.As(err, &pathError) || !reflect.DeepEqual(pathError.Path, []interface{}{"a", "b", 0, "c"}) {
+		t.Error("Marshal() error does not match")
+		t.Errorf("Expecting %v, got %v", "$.a.b[0].c", err)
+		return
+	}
+}
+
+func TestMarshalWithOptions(t *testing.T) {
+	options := DefaultOptions
+	options.Indent = "  "
+	data, _ := MarshalWithOptions(map[string]interface{}{"a": map[string]interface{}{"b": []int{1, 2}}}, options)
+	expecting := "<a>\n  <b>1</b>\n  <b>2</b>\n</a>"
+	if string(data) != expecting {
+		t.Error("MarshalWithOptions(v, options) XML does not match")
+		t.Errorf("Expecting %v, got %v", expecting, string(data))
+		return
+	}
+}
+
+func TestEncode_RoundTrip(t *testing.T) {
+	source := `<x:feed xmlns:x="urn:x"><x:entry id="1">one</x:entry><x:entry id="2"><note>two</note>` +
+		`</x:entry><empty></empty></x:feed>`
+	document, err := Unmarshal([]byte(source))
+	if err != nil {
+		t.Error("Unmarshal(data) failed to parse XML")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	buffer := &bytes.Buffer{}
+	if err := Encode(buffer, document, DefaultOptions); err != nil {
+		t.Error("Encode(w, v, options) failed to write XML")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	if buffer.String() != source {
+		t.Error("Encode(w, v, options) does not round-trip")
+		t.Errorf("Expecting %v, got %v", source, buffer.String())
+		return
+	}
+}
+
+func TestEncode_RoundTrip_order(t *testing.T) {
+	sources := []string{
+		`<r><a>1</a><b></b><a>2</a></r>`,
+		`<r><a></a><b></b><a></a><c x="1"></c><b></b></r>`,
+		`<p>Hello <b>world</b>, and <i>you</i>!</p>`,
+		`<p><b>bold</b> tail</p>`,
+		`<p id="1">head <b>bold</b></p>`,
+	}
+	for _, source := range sources {
+		document, err := Unmarshal([]byte(source))
+		if err != nil {
+			t.Error("Unmarshal(data) failed to parse XML")
+			t.Errorf("Reason: %s", err.Error())
+			return
+		}
+		data, err := Marshal(document)
+		if err != nil {
+			t.Error("Marshal(v) failed to write XML")
+			t.Errorf("Reason: %s", err.Error())
+			return
+		}
+		if string(data) != source {
+			t.Error("Marshal(v) does not round-trip")
+			t.Errorf("Expecting %v, got %v", source, string(data))
+			return
+		}
+	}
+	document := collection.New().Set("r", collection.New().
+		Set("a", array.New(1, 2)).
+		Set("b", 3).
+		Set("c", 4).
+		Set("#order", array.New("a", "b", "a")))
+	data, _ := Marshal(document)
+	expecting := "<r><a>1</a><b>3</b><a>2</a><c>4</c></r>"
+	if string(data) != expecting {
+		t.Error("Marshal(v) children missing from the OrderKey do not match")
+		t.Errorf("Expecting %v, got %v", expecting, string(data))
+		return
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package xml
+
+import (
+	"errors"
+)
+
+var (
+	MismatchedElementError = errors.New("the given XML has an end element that does not match its start element")
+	NonMapTypeError        = errors.New("the given parameter v is a non-map type, " +
+		"parameter v should be a Collection, an Object or a map")
+	RootElementError = errors.New("the XML document or the given parameter v should have exactly one element, " +
+		"which is the root element")
+)
+
+// Options defines the options used to convert XML documents.
+// Use DefaultOptions as the starting point of custom options.
+type Options struct {
+	// AttributePrefix is the prefix of keys that hold attributes.
+	AttributePrefix string
+	// Indent is the string used to indent nested elements when writing a
+	// document. An empty Indent writes the document in a single line.
+	Indent string
+	// OrderKey is the key that holds the order of the child elements and
+	// the texts of an element, when they cannot be written back in
+	// document order otherwise. An empty OrderKey does not keep the order.
+	OrderKey string
+	// TextKey is the key that holds the text of an element.
+	TextKey string
+}
+
+// DefaultOptions defines the options used by the Marshal() and Unmarshal()
+// functions.
+var DefaultOptions = Options{
+	AttributePrefix: "@",
+	OrderKey:        "#order",
+	TextKey:         "#text",
+}
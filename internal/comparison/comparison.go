@@ -0,0 +1,44 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+// Package comparison holds the checks shared by the packages that use
+// arbitrary values as map keys, such as Set, Array and Collection.
+package comparison
+
+import (
+	"reflect"
+)
+
+// The IsComparable() function determines whether the value can be compared
+// using the == operator without panicking, which is also required to use
+// it as a map key.
+func IsComparable(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	return reflect.TypeOf(value).Comparable() && isComparableValue(reflect.ValueOf(value))
+}
+
+// The isComparableValue() function checks the interface values nested in a
+// struct or an array, because their dynamic type may not be comparable.
+func isComparableValue(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Interface:
+		return value.IsNil() || value.Elem().Type().Comparable() && isComparableValue(value.Elem())
+	case reflect.Struct:
+		for index := 0; index < value.NumField(); index++ {
+			if !isComparableValue(value.Field(index)) {
+				return false
+			}
+		}
+	case reflect.Array:
+		for index := 0; index < value.Len(); index++ {
+			if !isComparableValue(value.Index(index)) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,39 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package comparison
+
+import (
+	"testing"
+)
+
+func TestIsComparable(t *testing.T) {
+	type pair struct {
+		key   interface{}
+		value interface{}
+	}
+	tests := []struct {
+		value    interface{}
+		expected bool
+	}{
+		{nil, true},
+		{1, true},
+		{"a", true},
+		{pair{1, "a"}, true},
+		{[2]interface{}{1, pair{2, nil}}, true},
+		{[]int{1}, false},
+		{map[string]int{}, false},
+		{pair{1, []int{1}}, false},
+		{[2]interface{}{1, map[string]int{}}, false},
+		{pair{1, pair{2, []int{1}}}, false},
+	}
+	for _, test := range tests {
+		if result := IsComparable(test.value); result != test.expected {
+			t.Error("comparison.IsComparable() value does not match")
+			t.Errorf("Expecting %v, got %v for %#v", test.expected, result, test.value)
+			return
+		}
+	}
+}
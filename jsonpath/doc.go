@@ -0,0 +1,28 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+/*
+The JSONPath selects the nodes of a nested value using a JSONPath query, as
+defined by RFC 9535, such as $.store.book[?@.price < 10].title. A query can
+be compiled once using Compile() function, and then used to select the
+nodes of many values.
+
+Every feature of RFC 9535 is supported: member names, wildcards, indexes,
+slices, unions of selectors, recursive descent, and filter expressions with
+comparisons, logical operators and the length(), count(), match(),
+search() and value() functions.
+
+A query walks Arrays, Objects, Collections, native slices, native arrays
+and native maps. The members of a Collection are selected in insertion
+order, while the members of an Object or a native map are selected in the
+order of their keys, since a map does not keep any order. A key that is not
+a string is converted using KeyString() function of "collection" package.
+Each selected node is returned with its normalized path, such as
+$['store']['book'][0], which identifies the node in the value.
+
+A value that holds one of its ancestors is selected, but recursive descent
+does not follow it, so a circular value is descended only once.
+*/
+package jsonpath
@@ -0,0 +1,317 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package jsonpath
+
+import (
+	"reflect"
+
+	"github.com/with-go/standard/cycle"
+)
+
+// context holds the values that a query is evaluated against.
+type context struct {
+	// root is the value of $.
+	root interface{}
+	// current is the value of @, the node being filtered.
+	current interface{}
+}
+
+// query defines a parsed query, which is either the whole query or a query
+// inside a filter expression.
+type query struct {
+	isRelative bool
+	segments   []segment
+}
+
+// The evaluate() function returns the nodes selected by the query.
+func (query *query) evaluate(context *context) []Node {
+	nodes := []Node{{Path: []interface{}{}, Value: context.root}}
+	if query.isRelative {
+		nodes[0].Value = context.current
+	}
+	for _, segment := range query.segments {
+		nodes = segment.apply(context, nodes)
+	}
+	return nodes
+}
+
+// The isSingular() function determines whether the query selects at most
+// one node, which is the case when it only has child segments with a
+// single name or index selector.
+func (query *query) isSingular() bool {
+	for _, segment := range query.segments {
+		if segment.isDescendant || len(segment.selectors) != 1 {
+			return false
+		}
+		switch segment.selectors[0].(type) {
+		case nameSelector, indexSelector:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// The nodes() function returns the nodes selected by the query, so it can
+// be used as an argument of a function.
+func (query *query) nodes(context *context) []Node {
+	return query.evaluate(context)
+}
+
+// segment defines a child segment, or a descendant segment which applies
+// its selectors to every descendant of the nodes as well.
+type segment struct {
+	isDescendant bool
+	selectors    []selector
+}
+
+// The apply() function returns the nodes selected by the segment from the
+// given nodes.
+func (segment segment) apply(context *context, nodes []Node) []Node {
+	selected := []Node{}
+	for _, node := range nodes {
+		if !segment.isDescendant {
+			for _, selector := range segment.selectors {
+				selected = selector.apply(context, node, selected)
+			}
+			continue
+		}
+		descend(node, &cycle.Tracker{}, func(descendant Node) {
+			for _, selector := range segment.selectors {
+				selected = selector.apply(context, descendant, selected)
+			}
+		})
+	}
+	return selected
+}
+
+// The descend() function calls the visitor with the given node and each of
+// its descendants, visiting a node before its children. The children of a
+// node that holds one of its ancestors are not visited.
+func descend(node Node, tracker *cycle.Tracker, visitor func(node Node)) {
+	visitor(node)
+	if !tracker.Enter(node.Value) {
+		return
+	}
+	defer tracker.Leave(node.Value)
+	for _, child := range childrenOf(node) {
+		descend(child, tracker, visitor)
+	}
+}
+
+// selector defines a selector of a segment.
+type selector interface {
+	// apply() appends the nodes selected from the given node.
+	apply(context *context, node Node, selected []Node) []Node
+}
+
+// nameSelector selects the member with the given name of an object.
+type nameSelector struct {
+	name string
+}
+
+func (selector nameSelector) apply(context *context, node Node, selected []Node) []Node {
+	if member, ok := memberOf(node, selector.name); ok {
+		return append(selected, member)
+	}
+	return selected
+}
+
+// wildcardSelector selects every element of an array or member of an
+// object.
+type wildcardSelector struct{}
+
+func (selector wildcardSelector) apply(context *context, node Node, selected []Node) []Node {
+	return append(selected, childrenOf(node)...)
+}
+
+// indexSelector selects the element at the given index of an array.
+type indexSelector struct {
+	index int
+}
+
+func (selector indexSelector) apply(context *context, node Node, selected []Node) []Node {
+	if element, ok := elementOf(node, selector.index); ok {
+		return append(selected, element)
+	}
+	return selected
+}
+
+// sliceSelector selects the elements of an array from the start index up
+// to, but not including, the end index, using the given step. A missing
+// start or end is nil.
+type sliceSelector struct {
+	start *int
+	end   *int
+	step  int
+}
+
+func (selector sliceSelector) apply(context *context, node Node, selected []Node) []Node {
+	if kindOf(node.Value) != arrayKind || selector.step == 0 {
+		return selected
+	}
+	length := reflect.ValueOf(node.Value).Len()
+	bound := func(index *int, defaultIndex int, lower int, upper int) int {
+		if index == nil {
+			return defaultIndex
+		}
+		normalized := *index
+		if normalized < 0 {
+			normalized += length
+		}
+		if normalized < lower {
+			return lower
+		}
+		if normalized > upper {
+			return upper
+		}
+		return normalized
+	}
+	if selector.step > 0 {
+		start, end := bound(selector.start, 0, 0, length), bound(selector.end, length, 0, length)
+		for index := start; index < end; index += selector.step {
+			element, _ := elementOf(node, index)
+			selected = append(selected, element)
+		}
+		return selected
+	}
+	start, end := bound(selector.start, length-1, -1, length-1), bound(selector.end, -1, -1, length-1)
+	for index := start; index > end; index += selector.step {
+		element, _ := elementOf(node, index)
+		selected = append(selected, element)
+	}
+	return selected
+}
+
+// filterSelector selects every element of an array or member of an object
+// for which the given expression is true.
+type filterSelector struct {
+	expression logical
+}
+
+func (selector filterSelector) apply(context *context, node Node, selected []Node) []Node {
+	for _, child := range childrenOf(node) {
+		if selector.expression.test(context.with(child.Value)) {
+			selected = append(selected, child)
+		}
+	}
+	return selected
+}
+
+// The with() function returns a copy of the context whose current value is
+// the given value.
+func (context *context) with(current interface{}) *context {
+	with := *context
+	with.current = current
+	return &with
+}
+
+// logical defines an expression of LogicalType.
+type logical interface {
+	test(context *context) bool
+}
+
+// comparable defines an expression of ValueType, whose value is nothing if
+// it has no value.
+type comparable interface {
+	value(context *context) interface{}
+}
+
+// nodeList defines an expression of NodesType.
+type nodeList interface {
+	nodes(context *context) []Node
+}
+
+// orExpression is true if any of its operands is true.
+type orExpression []logical
+
+func (expression orExpression) test(context *context) bool {
+	for _, operand := range expression {
+		if operand.test(context) {
+			return true
+		}
+	}
+	return false
+}
+
+// andExpression is true if every operand is true.
+type andExpression []logical
+
+func (expression andExpression) test(context *context) bool {
+	for _, operand := range expression {
+		if !operand.test(context) {
+			return false
+		}
+	}
+	return true
+}
+
+// notExpression is true if its operand is false.
+type notExpression struct {
+	operand logical
+}
+
+func (expression notExpression) test(context *context) bool {
+	return !expression.operand.test(context)
+}
+
+// existence is true if its query selects at least one node.
+type existence struct {
+	query nodeList
+}
+
+func (expression existence) test(context *context) bool {
+	return len(expression.query.nodes(context)) > 0
+}
+
+// comparison compares the values of two expressions.
+type comparison struct {
+	operator string
+	left     comparable
+	right    comparable
+}
+
+func (expression comparison) test(context *context) bool {
+	left, right := expression.left.value(context), expression.right.value(context)
+	switch expression.operator {
+	case "==":
+		return equal(left, right)
+	case "!=":
+		return !equal(left, right)
+	case "<":
+		return less(left, right)
+	case "<=":
+		return less(left, right) || equal(left, right)
+	case ">":
+		return less(right, left)
+	case ">=":
+		return less(right, left) || equal(left, right)
+	}
+	return false
+}
+
+// literal is a string, number, true, false or null literal.
+type literal struct {
+	constant interface{}
+}
+
+func (expression literal) value(context *context) interface{} {
+	return expression.constant
+}
+
+// singularQuery is the value of the node selected by a singular query, or
+// nothing if it selects no node.
+type singularQuery struct {
+	query *query
+}
+
+func (expression singularQuery) value(context *context) interface{} {
+	nodes := expression.query.evaluate(context)
+	if len(nodes) == 0 {
+		return nothing
+	}
+	return nodes[0].Value
+}
@@ -0,0 +1,170 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package jsonpath
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/with-go/standard/array"
+	"github.com/with-go/standard/collection"
+	"github.com/with-go/standard/object"
+)
+
+func values(t *testing.T, query string, root interface{}) []interface{} {
+	compiled, err := Compile(query)
+	if err != nil {
+		t.Fatalf("jsonpath.Compile() returns an error for %s: %v", query, err)
+	}
+	return compiled.Values(root)
+}
+
+func TestQuery_Select_slice(t *testing.T) {
+	root := array.New("a", "b", "c", "d", "e", "f", "g")
+	tests := []struct {
+		query    string
+		expected []interface{}
+	}{
+		{"$[1:3]", []interface{}{"b", "c"}},
+		{"$[5:]", []interface{}{"f", "g"}},
+		{"$[1:5:2]", []interface{}{"b", "d"}},
+		{"$[5:1:-2]", []interface{}{"f", "d"}},
+		{"$[::-1]", []interface{}{"g", "f", "e", "d", "c", "b", "a"}},
+		{"$[-2:]", []interface{}{"f", "g"}},
+		{"$[:-5]", []interface{}{"a", "b"}},
+		{"$[-100:2]", []interface{}{"a", "b"}},
+		{"$[1:100:3]", []interface{}{"b", "e"}},
+		{"$[::0]", []interface{}{}},
+		{"$[0, 3, -1]", []interface{}{"a", "d", "g"}},
+		{"$[0:2, 5]", []interface{}{"a", "b", "f"}},
+		{"$[7]", []interface{}{}},
+		{"$[-8]", []interface{}{}},
+	}
+	for _, test := range tests {
+		if result := values(t, test.query, root); !reflect.DeepEqual(result, test.expected) {
+			t.Error("query.Select() value does not match for " + test.query)
+			t.Errorf("Expecting %v, got %v", test.expected, result)
+			return
+		}
+	}
+}
+
+func TestQuery_Select_descendant(t *testing.T) {
+	root := collection.New().
+		Set("o", collection.New().Set("j", 1).Set("k", 2)).
+		Set("a", array.New(5, 3, array.New(collection.New().Set("j", 4).Set("k", 6))))
+	tests := []struct {
+		query    string
+		expected []string
+	}{
+		{"$..j", []string{"$['o']['j']", "$['a'][2][0]['j']"}},
+		{"$..[0]", []string{"$['a'][0]", "$['a'][2][0]"}},
+		{"$..*", []string{"$['o']", "$['a']", "$['o']['j']", "$['o']['k']", "$['a'][0]", "$['a'][1]", "$['a'][2]", "$['a'][2][0]", "$['a'][2][0]['j']", "$['a'][2][0]['k']"}},
+		{"$..[?@.j]", []string{"$['o']", "$['a'][2][0]"}},
+		{"$.o..[*, *]", []string{"$['o']['j']", "$['o']['k']", "$['o']['j']", "$['o']['k']"}},
+	}
+	for _, test := range tests {
+		nodes := MustCompile(test.query).Select(root)
+		if paths := normalizedPaths(nodes); !reflect.DeepEqual(paths, test.expected) {
+			t.Error("query.Select() paths do not match for " + test.query)
+			t.Errorf("Expecting %v, got %v", test.expected, paths)
+			return
+		}
+	}
+}
+
+func TestQuery_Select_comparison(t *testing.T) {
+	root := object.New().
+		Set("obj", object.New().Set("x", "y")).
+		Set("arr", array.New(2, 3))
+	tests := []struct {
+		expression string
+		expected   bool
+	}{
+		{"$.absent1 == $.absent2", true},
+		{"$.absent1 <= $.absent2", true},
+		{"$.absent == 'g'", false},
+		{"$.absent1 != $.absent2", false},
+		{"$.absent != 'g'", true},
+		{"1 <= 2", true},
+		{"1 > 2", false},
+		{"13 == '13'", false},
+		{"'a' <= 'b'", true},
+		{"'a' > 'b'", false},
+		{"$.obj == $.arr", false},
+		{"$.obj != $.arr", true},
+		{"$.obj == $.obj", true},
+		{"$.obj != $.obj", false},
+		{"$.arr == $.arr", true},
+		{"$.arr != $.arr", false},
+		{"$.obj == 17", false},
+		{"$.obj != 17", true},
+		{"$.obj <= $.arr", false},
+		{"$.obj < $.arr", false},
+		{"$.obj <= $.obj", true},
+		{"$.arr <= $.arr", true},
+		{"1 <= $.arr", false},
+		{"1 >= $.arr", false},
+		{"1 > $.arr", false},
+		{"1 < $.arr", false},
+		{"true <= true", true},
+		{"true > true", false},
+		{"null == null", true},
+		{"1 == 1.0", true},
+		{"-0 == 0", true},
+		{"1e2 == 100", true},
+	}
+	for _, test := range tests {
+		result := values(t, "$[?"+test.expression+"]", root)
+		if (len(result) == 2) != test.expected {
+			t.Error("query.Select() comparison does not match for " + test.expression)
+			t.Errorf("Expecting %v, got %v", test.expected, len(result) == 2)
+			return
+		}
+	}
+}
+
+func TestQuery_Select_filter(t *testing.T) {
+	root := array.New(
+		object.New().Set("a", 1).Set("b", "x"),
+		object.New().Set("a", int64(2)).Set("b", array.New(1, 2)),
+		object.New().Set("a", json.Number("3")).Set("c", nil),
+		map[string]interface{}{"a": uint8(4), "b": map[string]int{"c": 1}},
+		5,
+	)
+	tests := []struct {
+		query    string
+		expected []interface{}
+	}{
+		{"$[?@.a > 1 && @.a < 4].a", []interface{}{int64(2), json.Number("3")}},
+		{"$[?@.a == 1 || @.a == 4].a", []interface{}{1, uint8(4)}},
+		{"$[?!@.b].a", []interface{}{json.Number("3")}},
+		{"$[?@.c == null].a", []interface{}{json.Number("3")}},
+		{"$[?@.b == [1, 2]].a", nil},
+		{"$[?@.b[1] == 2].a", []interface{}{int64(2)}},
+		{"$[?@.b.c == 1].a", []interface{}{uint8(4)}},
+		{"$[?@ == 5]", []interface{}{5}},
+		{"$[?@.a == $[0].a].b", []interface{}{"x"}},
+		{"$[?@.*].a", []interface{}{1, int64(2), json.Number("3"), uint8(4)}},
+		{"$[1][?@ > 1]", []interface{}{int64(2)}},
+		{"$[3][?@.c]", []interface{}{map[string]int{"c": 1}}},
+	}
+	for _, test := range tests {
+		if test.expected == nil {
+			if _, err := Compile(test.query); err == nil {
+				t.Error("jsonpath.Compile() does not return an error for " + test.query)
+				return
+			}
+			continue
+		}
+		if result := values(t, test.query, root); !reflect.DeepEqual(result, test.expected) {
+			t.Error("query.Select() value does not match for " + test.query)
+			t.Errorf("Expecting %v, got %v", test.expected, result)
+			return
+		}
+	}
+}
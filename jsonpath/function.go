@@ -0,0 +1,228 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package jsonpath
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// expressionType defines the declared type of a function parameter or
+// result.
+type expressionType int
+
+const (
+	valueType expressionType = iota
+	logicalType
+	nodesType
+)
+
+// function defines a function extension of RFC 9535. The arguments are
+// passed as an interface{} for ValueType, a bool for LogicalType and a
+// []Node for NodesType, and the result is returned the same way.
+type function struct {
+	parameters []expressionType
+	result     expressionType
+	call       func(arguments []interface{}) interface{}
+	// compile converts a literal argument once, when the query is compiled,
+	// instead of on every call. It is nil if no argument is converted.
+	compile func(index int, constant interface{}) interface{}
+}
+
+// functions holds the functions defined by RFC 9535.
+var functions = map[string]function{
+	"length": {
+		parameters: []expressionType{valueType},
+		result:     valueType,
+		call: func(arguments []interface{}) interface{} {
+			switch kindOf(arguments[0]) {
+			case stringKind:
+				return utf8.RuneCountInString(reflect.ValueOf(arguments[0]).String())
+			case arrayKind, objectKind:
+				return lengthOf(arguments[0])
+			}
+			return nothing
+		},
+	},
+	"count": {
+		parameters: []expressionType{nodesType},
+		result:     valueType,
+		call: func(arguments []interface{}) interface{} {
+			return len(arguments[0].([]Node))
+		},
+	},
+	"match": {
+		parameters: []expressionType{valueType, valueType},
+		result:     logicalType,
+		call: func(arguments []interface{}) interface{} {
+			return matches(arguments[0], arguments[1], true)
+		},
+		compile: func(index int, constant interface{}) interface{} {
+			return compilePattern(index, constant, true)
+		},
+	},
+	"search": {
+		parameters: []expressionType{valueType, valueType},
+		result:     logicalType,
+		call: func(arguments []interface{}) interface{} {
+			return matches(arguments[0], arguments[1], false)
+		},
+		compile: func(index int, constant interface{}) interface{} {
+			return compilePattern(index, constant, false)
+		},
+	},
+	"value": {
+		parameters: []expressionType{nodesType},
+		result:     valueType,
+		call: func(arguments []interface{}) interface{} {
+			if nodes := arguments[0].([]Node); len(nodes) == 1 {
+				return nodes[0].Value
+			}
+			return nothing
+		},
+	},
+}
+
+// functionCall is a call of a function, which is an expression of the
+// result type of the function.
+type functionCall struct {
+	function  function
+	arguments []argument
+}
+
+// argument defines an argument of a function call, which is evaluated as
+// the type of its parameter.
+type argument func(context *context) interface{}
+
+// The call() function evaluates the arguments, and calls the function.
+func (expression *functionCall) call(context *context) interface{} {
+	arguments := make([]interface{}, len(expression.arguments))
+	for index, argument := range expression.arguments {
+		arguments[index] = argument(context)
+	}
+	return expression.function.call(arguments)
+}
+
+func (expression *functionCall) nodes(context *context) []Node {
+	return expression.call(context).([]Node)
+}
+
+func (expression *functionCall) test(context *context) bool {
+	if expression.function.result == nodesType {
+		return len(expression.nodes(context)) > 0
+	}
+	return expression.call(context).(bool)
+}
+
+func (expression *functionCall) value(context *context) interface{} {
+	return expression.call(context)
+}
+
+// compiledPattern is an I-Regexp given as a literal, which has been
+// compiled along with the query. The regexp is nil if the pattern is not a
+// valid I-Regexp.
+type compiledPattern struct {
+	regexp *regexp.Regexp
+}
+
+// The compilePattern() function compiles the pattern argument of the
+// match() and search() functions if it is a string literal, so a Query
+// compiles it only once. Any other argument is returned as it is.
+func compilePattern(index int, constant interface{}, isAnchored bool) interface{} {
+	if index != 1 || kindOf(constant) != stringKind {
+		return constant
+	}
+	return compiledPattern{regexp: compileRegexp(reflect.ValueOf(constant).String(), isAnchored)}
+}
+
+// The matches() function determines whether the given string matches the
+// given I-Regexp of RFC 9485. If the regular expression is anchored, the
+// whole string has to match. It returns false if either value is not a
+// string, or if the pattern is not a valid I-Regexp. A pattern that is not
+// a literal is compiled on every call.
+func matches(value interface{}, pattern interface{}, isAnchored bool) bool {
+	if kindOf(value) != stringKind {
+		return false
+	}
+	compiled, ok := pattern.(compiledPattern)
+	if !ok {
+		if kindOf(pattern) != stringKind {
+			return false
+		}
+		compiled.regexp = compileRegexp(reflect.ValueOf(pattern).String(), isAnchored)
+	}
+	return compiled.regexp != nil && compiled.regexp.MatchString(reflect.ValueOf(value).String())
+}
+
+// The compileRegexp() function translates the given I-Regexp into the
+// syntax of "regexp" package, and compiles it. It returns nil if the
+// pattern is not a valid I-Regexp. A dot does not match a carriage return
+// or a line feed, and ^ and $ are ordinary characters.
+func compileRegexp(pattern string, isAnchored bool) *regexp.Regexp {
+	var builder strings.Builder
+	isInClass := false
+	for index := 0; index < len(pattern); index++ {
+		character := pattern[index]
+		switch {
+		case character == '\\':
+			if index+1 == len(pattern) {
+				return nil
+			}
+			next := pattern[index+1]
+			switch {
+			case strings.IndexByte("\\|.-^?*+{}()[]nrt", next) != -1:
+				builder.WriteString(pattern[index : index+2])
+				index++
+			case next == 'p' || next == 'P':
+				end := strings.IndexByte(pattern[index:], '}')
+				if end == -1 || index+2 >= len(pattern) || pattern[index+2] != '{' {
+					return nil
+				}
+				builder.WriteString(pattern[index : index+end+1])
+				index += end
+			default:
+				return nil
+			}
+		case isInClass:
+			if character == '[' {
+				return nil
+			}
+			if character == ']' {
+				isInClass = false
+			}
+			builder.WriteByte(character)
+		case character == '[':
+			isInClass = true
+			builder.WriteByte(character)
+			if index+1 < len(pattern) && pattern[index+1] == '^' {
+				builder.WriteByte('^')
+				index++
+			}
+		case character == '.':
+			builder.WriteString("[^\\n\\r]")
+		case character == '^' || character == '$':
+			builder.WriteString("\\" + string(character))
+		case character == '(' && index+1 < len(pattern) && pattern[index+1] == '?':
+			return nil
+		default:
+			builder.WriteByte(character)
+		}
+	}
+	if isInClass {
+		return nil
+	}
+	translated := builder.String()
+	if isAnchored {
+		translated = "^(?:" + translated + ")$"
+	}
+	compiled, err := regexp.Compile(translated)
+	if err != nil {
+		return nil
+	}
+	return compiled
+}
@@ -0,0 +1,90 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package jsonpath
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/with-go/standard/array"
+	"github.com/with-go/standard/collection"
+	"github.com/with-go/standard/object"
+)
+
+func TestFunctions(t *testing.T) {
+	root := array.New(
+		object.New().Set("name", "añb").Set("tags", array.New("x", "y")),
+		object.New().Set("name", "ab\nc").Set("tags", []string{"x"}),
+		object.New().Set("name", "b.b").Set("tags", collection.New().Set("x", 1)),
+		object.New().Set("name", 1),
+	)
+	tests := []struct {
+		query    string
+		expected []interface{}
+	}{
+		{"$[?length(@.name) == 3].name", []interface{}{"añb", "b.b"}},
+		{"$[?length(@.tags) == 1].name", []interface{}{"ab\nc", "b.b"}},
+		{"$[?length(@.name) == 1].name", []interface{}{}},
+		{"$[?length(@.absent) == $.absent].name", []interface{}{"añb", "ab\nc", "b.b", 1}},
+		{"$[?count(@.tags[*]) == 2].name", []interface{}{"añb"}},
+		{"$[?count(@..*) == 1].name", []interface{}{1}},
+		{"$[?match(@.name, 'a.b')].name", []interface{}{"añb"}},
+		{"$[?match(@.name, 'a')].name", []interface{}{}},
+		{"$[?search(@.name, 'b')].name", []interface{}{"añb", "ab\nc", "b.b"}},
+		{"$[?search(@.name, 'b.c')].name", []interface{}{}},
+		{"$[?search(@.name, 'b\\\\.b')].name", []interface{}{"b.b"}},
+		{"$[?search(@.name, '^a')].name", []interface{}{}},
+		{"$[?search(@.name, '[^b]$')].name", []interface{}{}},
+		{"$[?search(@.name, '\\\\p{Ll}\\\\P{Ll}')].name", []interface{}{"ab\nc", "b.b"}},
+		{"$[?search(@.name, '\\\\d')].name", []interface{}{}},
+		{"$[?search(@.name, '(?i)A')].name", []interface{}{}},
+		{"$[?search(@.name, $[0].name)].name", []interface{}{"añb"}},
+		{"$[?match(@.name, 1)].name", []interface{}{}},
+		{"$[?match('abc', 'a.c')].name", []interface{}{"añb", "ab\nc", "b.b", 1}},
+		{"$[?!search(@.name, 'a')].name", []interface{}{"b.b", 1}},
+		{"$[?value(@.tags[*]) == 'x'].name", []interface{}{"ab\nc"}},
+		{"$[?value(@..x) == 1].name", []interface{}{"b.b"}},
+	}
+	for _, test := range tests {
+		if result := values(t, test.query, root); !reflect.DeepEqual(result, test.expected) {
+			t.Error("query.Select() value does not match for " + test.query)
+			t.Errorf("Expecting %v, got %v", test.expected, result)
+			return
+		}
+	}
+}
+
+func TestCompileRegexp(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		value    string
+		expected bool
+	}{
+		{"a.c", "abc", true},
+		{"a.c", "a\rc", false},
+		{"a.c", "a\nc", false},
+		{"a^b$", "a^b$", true},
+		{"[^a]+", "bcd", true},
+		{"[a-c]{2,3}", "abc", true},
+		{"x|y", "y", true},
+		{"\\p{Lu}", "A", true},
+		{"(ab)*", "abab", true},
+	}
+	for _, test := range tests {
+		compiled := compileRegexp(test.pattern, true)
+		if compiled == nil || compiled.MatchString(test.value) != test.expected {
+			t.Error("jsonpath.compileRegexp() value does not match for " + test.pattern)
+			t.Errorf("Expecting %v, got %v", test.expected, compiled)
+			return
+		}
+	}
+	for _, pattern := range []string{"\\d", "\\", "[a", "(?:a)", "a[[b]", "\\p{L"} {
+		if compileRegexp(pattern, false) != nil {
+			t.Error("jsonpath.compileRegexp() does not fail for " + pattern)
+			return
+		}
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package jsonpath
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	InvalidQueryError = errors.New("the given query is not a valid JSONPath")
+)
+
+// SyntaxError describes why a query is not a valid JSONPath. It wraps
+// InvalidQueryError, so it can be checked using errors.Is().
+type SyntaxError struct {
+	// Offset is the byte offset in the query where the error was found.
+	Offset int
+	// Message describes the error.
+	Message string
+}
+
+// The Error() function returns the error message, including the offset.
+func (err *SyntaxError) Error() string {
+	return fmt.Sprintf("%s: %s at offset %d", InvalidQueryError.Error(), err.Message, err.Offset)
+}
+
+// The Unwrap() function returns InvalidQueryError.
+func (err *SyntaxError) Unwrap() error {
+	return InvalidQueryError
+}
+
+// Node defines a node selected by a query.
+type Node struct {
+	// Path is the list of member names and indexes from the root to the
+	// node. A member name is a string and an index is an int.
+	Path []interface{}
+	// Value is the value of the node.
+	Value interface{}
+}
+
+// The NormalizedPath() function returns the Path written as a normalized
+// path of RFC 9535, such as $['store']['book'][0].
+func (node Node) NormalizedPath() string {
+	var builder strings.Builder
+	builder.WriteString("$")
+	for _, key := range node.Path {
+		if index, ok := key.(int); ok {
+			builder.WriteString("[" + strconv.Itoa(index) + "]")
+			continue
+		}
+		builder.WriteString("['")
+		for _, character := range key.(string) {
+			switch character {
+			case '\b':
+				builder.WriteString("\\b")
+			case '\f':
+				builder.WriteString("\\f")
+			case '\n':
+				builder.WriteString("\\n")
+			case '\r':
+				builder.WriteString("\\r")
+			case '\t':
+				builder.WriteString("\\t")
+			case '\'':
+				builder.WriteString("\\'")
+			case '\\':
+				builder.WriteString("\\\\")
+			default:
+				if character < 0x20 {
+					builder.WriteString(fmt.Sprintf("\\u%04x", character))
+				} else {
+					builder.WriteRune(character)
+				}
+			}
+		}
+		builder.WriteString("']")
+	}
+	return builder.String()
+}
+
+// The child() function returns the Node of an element of the Node.
+func (node Node) child(key interface{}, value interface{}) Node {
+	path := make([]interface{}, len(node.Path), len(node.Path)+1)
+	copy(path, node.Path)
+	return Node{Path: append(path, key), Value: value}
+}
+
+// Query defines a compiled JSONPath query, which is safe to use by multiple
+// goroutines at the same time.
+type Query struct {
+	expression string
+	query      *query
+}
+
+// The Compile() function parses the given expression as a JSONPath query.
+// It returns a SyntaxError if the expression is not a valid JSONPath.
+func Compile(expression string) (*Query, error) {
+	parsed, err := parse(expression)
+	if err != nil {
+		return nil, err
+	}
+	return &Query{expression: expression, query: parsed}, nil
+}
+
+// The MustCompile() function is like Compile() function, but panics if the
+// expression is not a valid JSONPath.
+func MustCompile(expression string) *Query {
+	query, err := Compile(expression)
+	if err != nil {
+		panic(err)
+	}
+	return query
+}
+
+// The Select() function compiles the given expression, and returns the
+// nodes of the given root selected by it.
+func Select(expression string, root interface{}) ([]Node, error) {
+	query, err := Compile(expression)
+	if err != nil {
+		return nil, err
+	}
+	return query.Select(root), nil
+}
+
+// The Select() function returns the nodes of the given root selected by
+// the Query, in the order defined by RFC 9535.
+func (query *Query) Select(root interface{}) []Node {
+	return query.query.evaluate(&context{root: root, current: root})
+}
+
+// The String() function returns the expression of the Query.
+func (query *Query) String() string {
+	return query.expression
+}
+
+// The Values() function returns the values of the nodes of the given root
+// selected by the Query.
+func (query *Query) Values(root interface{}) []interface{} {
+	nodes := query.Select(root)
+	values := make([]interface{}, len(nodes))
+	for index, node := range nodes {
+		values[index] = node.Value
+	}
+	return values
+}
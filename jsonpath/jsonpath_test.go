@@ -0,0 +1,192 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package jsonpath
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/with-go/standard/array"
+	"github.com/with-go/standard/collection"
+	"github.com/with-go/standard/object"
+)
+
+func store() object.Object {
+	book := func(category string, author string, title string, price float64) object.Object {
+		return object.New().
+			Set("category", category).
+			Set("author", author).
+			Set("title", title).
+			Set("price", price)
+	}
+	return object.New().Set("store", object.New().
+		Set("book", array.New(
+			book("reference", "Nigel Rees", "Sayings of the Century", 8.95),
+			book("fiction", "Evelyn Waugh", "Sword of Honour", 12.99),
+			book("fiction", "Herman Melville", "Moby Dick", 8.99).Set("isbn", "0-553-21311-3"),
+			book("fiction", "J. R. R. Tolkien", "The Lord of the Rings", 22.99).Set("isbn", "0-395-19395-8"),
+		)).
+		Set("bicycle", object.New().Set("color", "red").Set("price", 399)))
+}
+
+func normalizedPaths(nodes []Node) []string {
+	paths := make([]string, len(nodes))
+	for index, node := range nodes {
+		paths[index] = node.NormalizedPath()
+	}
+	return paths
+}
+
+func TestSelect(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected []string
+	}{
+		{"$.store.book[*].author", []string{"$['store']['book'][0]['author']", "$['store']['book'][1]['author']", "$['store']['book'][2]['author']", "$['store']['book'][3]['author']"}},
+		{"$..author", []string{"$['store']['book'][0]['author']", "$['store']['book'][1]['author']", "$['store']['book'][2]['author']", "$['store']['book'][3]['author']"}},
+		{"$.store.*", []string{"$['store']['bicycle']", "$['store']['book']"}},
+		{"$.store..price", []string{"$['store']['bicycle']['price']", "$['store']['book'][0]['price']", "$['store']['book'][1]['price']", "$['store']['book'][2]['price']", "$['store']['book'][3]['price']"}},
+		{"$..book[2]", []string{"$['store']['book'][2]"}},
+		{"$..book[-1]", []string{"$['store']['book'][3]"}},
+		{"$..book[0,1]", []string{"$['store']['book'][0]", "$['store']['book'][1]"}},
+		{"$..book[:2]", []string{"$['store']['book'][0]", "$['store']['book'][1]"}},
+		{"$..book[?@.isbn]", []string{"$['store']['book'][2]", "$['store']['book'][3]"}},
+		{"$..book[?@.price<10]", []string{"$['store']['book'][0]", "$['store']['book'][2]"}},
+		{"$..book[?@.price < 10 && @.category == 'fiction'].title", []string{"$['store']['book'][2]['title']"}},
+		{"$.nothing", []string{}},
+	}
+	for _, test := range tests {
+		nodes, err := Select(test.query, store())
+		if err != nil {
+			t.Error("jsonpath.Select() returns an error")
+			t.Errorf("Expecting %v, got %v", nil, err)
+			return
+		}
+		if paths := normalizedPaths(nodes); !reflect.DeepEqual(paths, test.expected) {
+			t.Error("jsonpath.Select() paths do not match for " + test.query)
+			t.Errorf("Expecting %v, got %v", test.expected, paths)
+			return
+		}
+	}
+	if _, err := Select("$.", store()); !errors.Is(err, InvalidQueryError) {
+		t.Error("jsonpath.Select() error does not match")
+		t.Errorf("Expecting %v, got %v", InvalidQueryError, err)
+		return
+	}
+}
+
+func TestSelect_collection(t *testing.T) {
+	root := collection.New().
+		Set("z", collection.New().Set("price", 1)).
+		Set("a", collection.New().Set("price", 2).Set(10, collection.New().Set("price", 3))).
+		Set("m", array.New(map[string]interface{}{"price": 4}))
+	nodes, err := Select("$..price", root)
+	if err != nil {
+		t.Error("jsonpath.Select() returns an error")
+		t.Errorf("Expecting %v, got %v", nil, err)
+		return
+	}
+	expected := []string{"$['z']['price']", "$['a']['price']", "$['a']['10']['price']", "$['m'][0]['price']"}
+	if paths := normalizedPaths(nodes); !reflect.DeepEqual(paths, expected) {
+		t.Error("jsonpath.Select() paths do not match")
+		t.Errorf("Expecting %v, got %v", expected, paths)
+		return
+	}
+	values := []interface{}{1, 2, 3, 4}
+	for index, node := range nodes {
+		if node.Value != values[index] {
+			t.Error("jsonpath.Select() value does not match")
+			t.Errorf("Expecting %v, got %v", values[index], node.Value)
+			return
+		}
+	}
+}
+
+func TestSelect_circular(t *testing.T) {
+	root := object.New().Set("a", 1)
+	root["self"] = root
+	nodes, err := Select("$..a", root)
+	if err != nil {
+		t.Error("jsonpath.Select() returns an error")
+		t.Errorf("Expecting %v, got %v", nil, err)
+		return
+	}
+	expected := []string{"$['a']", "$['self']['a']"}
+	if paths := normalizedPaths(nodes); !reflect.DeepEqual(paths, expected) {
+		t.Error("jsonpath.Select() circular paths do not match")
+		t.Errorf("Expecting %v, got %v", expected, paths)
+		return
+	}
+}
+
+func TestSelect_circularEqual(t *testing.T) {
+	x := collection.New().Set("a", 1)
+	x.Set("self", x)
+	y := collection.New().Set("a", 1)
+	y.Set("self", y)
+	z := collection.New().Set("a", 2)
+	z.Set("self", z)
+	root := object.New().Set("x", x).Set("y", y).Set("z", z)
+	nodes, err := Select("$[?@ == $.y]", root)
+	if err != nil {
+		t.Error("jsonpath.Select() returns an error")
+		t.Errorf("Expecting %v, got %v", nil, err)
+		return
+	}
+	expected := []string{"$['x']", "$['y']"}
+	if paths := normalizedPaths(nodes); !reflect.DeepEqual(paths, expected) {
+		t.Error("jsonpath.Select() circular comparison does not match")
+		t.Errorf("Expecting %v, got %v", expected, paths)
+		return
+	}
+}
+
+func TestCompile(t *testing.T) {
+	query, err := Compile("$.a[?@ > 1]")
+	if err != nil {
+		t.Error("jsonpath.Compile() returns an error")
+		t.Errorf("Expecting %v, got %v", nil, err)
+		return
+	}
+	if query.String() != "$.a[?@ > 1]" {
+		t.Error("query.String() value does not match")
+		t.Errorf("Expecting %v, got %v", "$.a[?@ > 1]", query.String())
+		return
+	}
+	values := query.Values(object.New().Set("a", []int{1, 2, 3}))
+	if !reflect.DeepEqual(values, []interface{}{2, 3}) {
+		t.Error("query.Values() value does not match")
+		t.Errorf("Expecting %v, got %v", []interface{}{2, 3}, values)
+		return
+	}
+	_, err = Compile("$[?@.a == ]")
+	var syntaxError *SyntaxError
+	if !errors.As(err, &syntaxError) || syntaxError.Offset != 10 {
+		t.Error("jsonpath.Compile() error does not match")
+		t.Errorf("Expecting offset %v, got %v", 10, err)
+		return
+	}
+}
+
+func TestMustCompile(t *testing.T) {
+	defer func() {
+		if recovered := recover(); recovered == nil {
+			t.Error("jsonpath.MustCompile() does not panic")
+		}
+	}()
+	MustCompile("store")
+}
+
+func TestNode_NormalizedPath(t *testing.T) {
+	node := Node{Path: []interface{}{"a", 1, "it's", "back\\slash", "\b\f\n\r\t\x01", "ü"}}
+	expected := "$['a'][1]['it\\'s']['back\\\\slash']['\\b\\f\\n\\r\\t\\u0001']['ü']"
+	if node.NormalizedPath() != expected {
+		t.Error("node.NormalizedPath() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, node.NormalizedPath())
+		return
+	}
+}
@@ -0,0 +1,618 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package jsonpath
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// The largest and smallest integers allowed in a query, which are the
+// integers that a float64 holds exactly.
+const (
+	maxInteger = 1<<53 - 1
+	minInteger = -maxInteger
+)
+
+// parser parses a query using recursive descent. A syntax error is raised
+// as a panic of *SyntaxError, which is recovered by parse() function.
+type parser struct {
+	input    string
+	position int
+}
+
+// The parse() function parses the given expression as a JSONPath query.
+func parse(expression string) (parsed *query, err error) {
+	parser := &parser{input: expression}
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			syntaxError, ok := recovered.(*SyntaxError)
+			if !ok {
+				panic(recovered)
+			}
+			err = syntaxError
+		}
+	}()
+	if !parser.consume("$") {
+		parser.fail("expecting $")
+	}
+	parsed = &query{segments: parser.segments()}
+	if parser.position != len(parser.input) {
+		parser.unexpected()
+	}
+	return parsed, nil
+}
+
+// The fail() function raises a SyntaxError at the current position.
+func (parser *parser) fail(format string, arguments ...interface{}) {
+	panic(&SyntaxError{Offset: parser.position, Message: fmt.Sprintf(format, arguments...)})
+}
+
+// The consume() function skips the given prefix if the input continues
+// with it, and returns whether it does.
+func (parser *parser) consume(prefix string) bool {
+	if strings.HasPrefix(parser.input[parser.position:], prefix) {
+		parser.position += len(prefix)
+		return true
+	}
+	return false
+}
+
+// The expect() function skips the given prefix, or fails if the input does
+// not continue with it.
+func (parser *parser) expect(prefix string) {
+	if !parser.consume(prefix) {
+		parser.unexpected()
+	}
+}
+
+// The peek() function returns the next byte, or zero at the end of the
+// input.
+func (parser *parser) peek() byte {
+	if parser.position == len(parser.input) {
+		return 0
+	}
+	return parser.input[parser.position]
+}
+
+// The peekRune() function returns the next character, and its length in
+// bytes. An invalid UTF-8 encoding is returned as utf8.RuneError with a
+// length of one byte.
+func (parser *parser) peekRune() (rune, int) {
+	return utf8.DecodeRuneInString(parser.input[parser.position:])
+}
+
+// The unexpected() function fails with the next character.
+func (parser *parser) unexpected() {
+	if parser.position == len(parser.input) {
+		parser.fail("unexpected end of query")
+	}
+	character, _ := parser.peekRune()
+	parser.fail("unexpected %q", character)
+}
+
+// The blank() function skips the spaces, tabs, line feeds and carriage
+// returns.
+func (parser *parser) blank() {
+	for strings.IndexByte(" \t\n\r", parser.peek()) != -1 {
+		parser.position++
+	}
+}
+
+// The segments() function parses the segments following $ or @.
+func (parser *parser) segments() []segment {
+	segments := []segment{}
+	for {
+		start := parser.position
+		parser.blank()
+		switch {
+		case parser.consume(".."):
+			segments = append(segments, segment{isDescendant: true, selectors: parser.shorthand(true)})
+		case parser.consume("."):
+			segments = append(segments, segment{selectors: parser.shorthand(false)})
+		case parser.peek() == '[':
+			segments = append(segments, segment{selectors: parser.bracketed()})
+		default:
+			parser.position = start
+			return segments
+		}
+	}
+}
+
+// The shorthand() function parses the selector following . or .., which
+// is either a wildcard or a member name. A bracketed selection can follow
+// .. as well.
+func (parser *parser) shorthand(isDescendant bool) []selector {
+	if parser.consume("*") {
+		return []selector{wildcardSelector{}}
+	}
+	if isDescendant && parser.peek() == '[' {
+		return parser.bracketed()
+	}
+	start := parser.position
+	for parser.position < len(parser.input) {
+		character, size := parser.peekRune()
+		if character == utf8.RuneError && size == 1 {
+			parser.fail("invalid UTF-8 encoding")
+		}
+		if !isNameFirst(character) && !(parser.position > start && character >= '0' && character <= '9') {
+			break
+		}
+		parser.position += size
+	}
+	if parser.position == start {
+		parser.fail("expecting a member name")
+	}
+	return []selector{nameSelector{name: parser.input[start:parser.position]}}
+}
+
+// The isNameFirst() function determines whether the given character can
+// start a member name.
+func isNameFirst(character rune) bool {
+	return character >= 'a' && character <= 'z' ||
+		character >= 'A' && character <= 'Z' ||
+		character == '_' ||
+		character >= 0x80
+}
+
+// The bracketed() function parses the selectors of a bracketed selection.
+func (parser *parser) bracketed() []selector {
+	parser.expect("[")
+	selectors := []selector{}
+	for {
+		parser.blank()
+		selectors = append(selectors, parser.selector())
+		parser.blank()
+		if parser.consume("]") {
+			return selectors
+		}
+		parser.expect(",")
+	}
+}
+
+// The selector() function parses a selector of a bracketed selection.
+func (parser *parser) selector() selector {
+	switch character := parser.peek(); {
+	case character == '\'' || character == '"':
+		return nameSelector{name: parser.string()}
+	case parser.consume("*"):
+		return wildcardSelector{}
+	case parser.consume("?"):
+		parser.blank()
+		return filterSelector{expression: parser.or()}
+	}
+	start, hasStart := parser.optionalInteger()
+	parser.blank()
+	if !parser.consume(":") {
+		if !hasStart {
+			parser.fail("expecting a selector")
+		}
+		return indexSelector{index: start}
+	}
+	selector := sliceSelector{step: 1}
+	if hasStart {
+		selector.start = &start
+	}
+	parser.blank()
+	if end, hasEnd := parser.optionalInteger(); hasEnd {
+		selector.end = &end
+		parser.blank()
+	}
+	if parser.consume(":") {
+		parser.blank()
+		if step, hasStep := parser.optionalInteger(); hasStep {
+			selector.step = step
+		}
+	}
+	return selector
+}
+
+// The optionalInteger() function parses an integer if the input continues
+// with one.
+func (parser *parser) optionalInteger() (int, bool) {
+	if character := parser.peek(); character != '-' && (character < '0' || character > '9') {
+		return 0, false
+	}
+	return parser.integer(), true
+}
+
+// The integer() function parses an integer, which has no leading zero and
+// is within the range of integers that a float64 holds exactly.
+func (parser *parser) integer() int {
+	start := parser.position
+	parser.consume("-")
+	digits := parser.position
+	for character := parser.peek(); character >= '0' && character <= '9'; character = parser.peek() {
+		parser.position++
+	}
+	text := parser.input[start:parser.position]
+	switch {
+	case parser.position == digits:
+		parser.fail("expecting a digit")
+	case parser.input[digits] == '0' && (parser.position-digits > 1 || digits > start):
+		parser.position = start
+		parser.fail("invalid integer %q", text)
+	}
+	integer, err := strconv.ParseInt(text, 10, 64)
+	if err != nil || integer < minInteger || integer > maxInteger {
+		parser.position = start
+		parser.fail("integer %s out of range", text)
+	}
+	return int(integer)
+}
+
+// The string() function parses a string literal in single or double
+// quotes.
+func (parser *parser) string() string {
+	quote := parser.input[parser.position]
+	parser.position++
+	var builder strings.Builder
+	for {
+		if parser.position == len(parser.input) {
+			parser.fail("unterminated string")
+		}
+		character, size := parser.peekRune()
+		switch {
+		case character == utf8.RuneError && size == 1:
+			parser.fail("invalid UTF-8 encoding")
+		case character == rune(quote):
+			parser.position++
+			return builder.String()
+		case character < 0x20:
+			parser.fail("invalid control character %q in string", character)
+		case character == '\\':
+			parser.position++
+			builder.WriteRune(parser.escape(quote))
+		default:
+			builder.WriteRune(character)
+			parser.position += size
+		}
+	}
+}
+
+// The escape() function parses the escape sequence following a backslash
+// in a string literal.
+func (parser *parser) escape(quote byte) rune {
+	character := parser.peek()
+	parser.position++
+	switch character {
+	case 'b':
+		return '\b'
+	case 'f':
+		return '\f'
+	case 'n':
+		return '\n'
+	case 'r':
+		return '\r'
+	case 't':
+		return '\t'
+	case '/', '\\':
+		return rune(character)
+	case '\'', '"':
+		if character == quote {
+			return rune(character)
+		}
+	case 'u':
+		high := parser.hexadecimal()
+		if !utf16.IsSurrogate(high) {
+			return high
+		}
+		if high < 0xDC00 && parser.consume("\\u") {
+			if low := parser.hexadecimal(); low >= 0xDC00 && low <= 0xDFFF {
+				return utf16.DecodeRune(high, low)
+			}
+		}
+		parser.fail("invalid surrogate pair")
+	}
+	parser.position--
+	parser.fail("invalid escape sequence")
+	return 0
+}
+
+// The hexadecimal() function parses the four hexadecimal digits of a
+// unicode escape sequence.
+func (parser *parser) hexadecimal() rune {
+	if parser.position+4 > len(parser.input) {
+		parser.fail("invalid unicode escape sequence")
+	}
+	value, err := strconv.ParseUint(parser.input[parser.position:parser.position+4], 16, 32)
+	if err != nil {
+		parser.fail("invalid unicode escape sequence")
+	}
+	parser.position += 4
+	return rune(value)
+}
+
+// The or() function parses a logical expression, which is one or more
+// logical and expressions separated by ||.
+func (parser *parser) or() logical {
+	operands := orExpression{parser.and()}
+	for {
+		start := parser.position
+		parser.blank()
+		if !parser.consume("||") {
+			parser.position = start
+			break
+		}
+		parser.blank()
+		operands = append(operands, parser.and())
+	}
+	if len(operands) == 1 {
+		return operands[0]
+	}
+	return operands
+}
+
+// The and() function parses one or more basic expressions separated by &&.
+func (parser *parser) and() logical {
+	operands := andExpression{parser.basic()}
+	for {
+		start := parser.position
+		parser.blank()
+		if !parser.consume("&&") {
+			parser.position = start
+			break
+		}
+		parser.blank()
+		operands = append(operands, parser.basic())
+	}
+	if len(operands) == 1 {
+		return operands[0]
+	}
+	return operands
+}
+
+// The basic() function parses a parenthesized expression, a comparison or
+// a test expression, any of them except a comparison optionally negated
+// using !.
+func (parser *parser) basic() logical {
+	if parser.consume("!") {
+		parser.blank()
+		if parser.peek() == '(' {
+			return notExpression{operand: parser.parenthesized()}
+		}
+		start := parser.position
+		return notExpression{operand: parser.test(start, parser.operand())}
+	}
+	if parser.peek() == '(' {
+		return parser.parenthesized()
+	}
+	start := parser.position
+	left := parser.operand()
+	end := parser.position
+	parser.blank()
+	operator := parser.operator()
+	if operator == "" {
+		parser.position = end
+		return parser.test(start, left)
+	}
+	parser.blank()
+	rightStart := parser.position
+	right := parser.operand()
+	return comparison{
+		operator: operator,
+		left:     parser.comparable(start, left),
+		right:    parser.comparable(rightStart, right),
+	}
+}
+
+// The parenthesized() function parses a logical expression in parentheses.
+func (parser *parser) parenthesized() logical {
+	parser.expect("(")
+	parser.blank()
+	expression := parser.or()
+	parser.blank()
+	parser.expect(")")
+	return expression
+}
+
+// The operator() function parses a comparison operator, or returns an
+// empty string if the input does not continue with one.
+func (parser *parser) operator() string {
+	for _, operator := range []string{"==", "!=", "<=", ">=", "<", ">"} {
+		if parser.consume(operator) {
+			return operator
+		}
+	}
+	return ""
+}
+
+// The operand() function parses a literal, a query or a function call,
+// which are the operands of a comparison or a test expression. It returns
+// a literal, a *query or a *functionCall.
+func (parser *parser) operand() interface{} {
+	switch character := parser.peek(); {
+	case character == '$' || character == '@':
+		parser.position++
+		return &query{isRelative: character == '@', segments: parser.segments()}
+	case character == '\'' || character == '"':
+		return literal{constant: parser.string()}
+	case character == '-' || character >= '0' && character <= '9':
+		return literal{constant: parser.number()}
+	case character >= 'a' && character <= 'z':
+		start := parser.position
+		for character := parser.peek(); character >= 'a' && character <= 'z' || character == '_' || character >= '0' && character <= '9'; character = parser.peek() {
+			parser.position++
+		}
+		name := parser.input[start:parser.position]
+		if parser.peek() == '(' {
+			return parser.call(start, name)
+		}
+		switch name {
+		case "true":
+			return literal{constant: true}
+		case "false":
+			return literal{constant: false}
+		case "null":
+			return literal{constant: nil}
+		}
+		parser.position = start
+		parser.fail("unknown literal %q", name)
+	}
+	parser.unexpected()
+	return nil
+}
+
+// The number() function parses a number literal, which is an integer or
+// a float64.
+func (parser *parser) number() interface{} {
+	start := parser.position
+	parser.consume("-")
+	digits := parser.position
+	for character := parser.peek(); character >= '0' && character <= '9'; character = parser.peek() {
+		parser.position++
+	}
+	if parser.position == digits || parser.input[digits] == '0' && parser.position-digits > 1 {
+		parser.position = start
+		parser.fail("invalid number")
+	}
+	isFloat := false
+	if parser.consume(".") {
+		isFloat = true
+		fraction := parser.position
+		for character := parser.peek(); character >= '0' && character <= '9'; character = parser.peek() {
+			parser.position++
+		}
+		if parser.position == fraction {
+			parser.fail("expecting a digit")
+		}
+	}
+	if character := parser.peek(); character == 'e' || character == 'E' {
+		isFloat = true
+		parser.position++
+		if character := parser.peek(); character == '+' || character == '-' {
+			parser.position++
+		}
+		exponent := parser.position
+		for character := parser.peek(); character >= '0' && character <= '9'; character = parser.peek() {
+			parser.position++
+		}
+		if parser.position == exponent {
+			parser.fail("expecting a digit")
+		}
+	}
+	text := parser.input[start:parser.position]
+	if !isFloat && text != "-0" {
+		if integer, err := strconv.ParseInt(text, 10, 64); err == nil {
+			return integer
+		}
+	}
+	float, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		parser.position = start
+		parser.fail("number %s out of range", text)
+	}
+	return float
+}
+
+// The call() function parses the arguments of a call of the function with
+// the given name, and checks them against the parameters of the function.
+func (parser *parser) call(start int, name string) *functionCall {
+	function, ok := functions[name]
+	if !ok {
+		parser.position = start
+		parser.fail("unknown function %s()", name)
+	}
+	parser.expect("(")
+	call := &functionCall{function: function}
+	for index := range function.parameters {
+		parser.blank()
+		if index > 0 {
+			parser.expect(",")
+			parser.blank()
+		}
+		call.arguments = append(call.arguments, parser.argument(function, index))
+	}
+	parser.blank()
+	if parser.peek() != ')' {
+		parser.fail("%s() takes %d argument(s)", name, len(function.parameters))
+	}
+	parser.position++
+	return call
+}
+
+// The argument() function parses the argument of a function call at the
+// given index, and converts it to the type of its parameter.
+func (parser *parser) argument(function function, index int) argument {
+	start := parser.position
+	parameter := function.parameters[index]
+	if parameter == logicalType {
+		expression := parser.or()
+		return func(context *context) interface{} {
+			return expression.test(context)
+		}
+	}
+	if parameter == nodesType {
+		if nodes, ok := parser.operand().(nodeList); ok && !isValueCall(nodes) {
+			return func(context *context) interface{} {
+				return nodes.nodes(context)
+			}
+		}
+		parser.position = start
+		parser.fail("expecting a query or a function of NodesType")
+	}
+	expression := parser.comparable(start, parser.operand())
+	if constant, ok := expression.(literal); ok && function.compile != nil {
+		compiled := function.compile(index, constant.constant)
+		return func(context *context) interface{} {
+			return compiled
+		}
+	}
+	return func(context *context) interface{} {
+		return expression.value(context)
+	}
+}
+
+// The isValueCall() function determines whether the given node list is a
+// call of a function whose result is not of NodesType.
+func isValueCall(nodes nodeList) bool {
+	call, ok := nodes.(*functionCall)
+	return ok && call.function.result != nodesType
+}
+
+// The comparable() function converts the given operand to an expression of
+// ValueType, which is a literal, a singular query, or a call of a function
+// of ValueType.
+func (parser *parser) comparable(start int, operand interface{}) comparable {
+	switch operand := operand.(type) {
+	case literal:
+		return operand
+	case *query:
+		if operand.isSingular() {
+			return singularQuery{query: operand}
+		}
+		parser.position = start
+		parser.fail("a query that may select more than one node cannot be compared")
+	case *functionCall:
+		if operand.function.result == valueType {
+			return operand
+		}
+		parser.position = start
+		parser.fail("a function whose result is not of ValueType cannot be compared")
+	}
+	return nil
+}
+
+// The test() function converts the given operand to a test expression,
+// which is a query, or a call of a function of LogicalType or NodesType.
+func (parser *parser) test(start int, operand interface{}) logical {
+	switch operand := operand.(type) {
+	case *query:
+		return existence{query: operand}
+	case *functionCall:
+		if operand.function.result != valueType {
+			return operand
+		}
+		parser.position = start
+		parser.fail("a function of ValueType cannot be used as a test")
+	}
+	parser.position = start
+	parser.fail("a literal cannot be used as a test")
+	return nil
+}
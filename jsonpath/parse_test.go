@@ -0,0 +1,138 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package jsonpath
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCompile_valid(t *testing.T) {
+	queries := []string{
+		"$",
+		"$.a",
+		"$.a.b_1",
+		"$.ü",
+		"$._",
+		"$['a']",
+		"$[\"a\"]",
+		"$['\\'\\\\\\/\\b\\f\\n\\r\\t\\u00e9\\uD83D\\uDE00']",
+		"$[\"'\\\"\"]",
+		"$.*",
+		"$[*]",
+		"$..a",
+		"$..*",
+		"$..[0]",
+		"$[0]",
+		"$[-1]",
+		"$[9007199254740991]",
+		"$[1:2]",
+		"$[:]",
+		"$[::]",
+		"$[::-1]",
+		"$[ 1 : 2 : 3 ]",
+		"$[ 'a' , 1 , * ]",
+		"$ .a [0] ..b",
+		"$[?@]",
+		"$[?$.a]",
+		"$[?@.a == 1]",
+		"$[?@.a==-0.5e+3]",
+		"$[?@.a != 'b']",
+		"$[?@.a < 1 || @.b >= 2 && !@.c]",
+		"$[?!(@.a == 1)]",
+		"$[?(@.a)]",
+		"$[?@[0] == null]",
+		"$[?@.a == true && @.b == false]",
+		"$[?length(@) > 1]",
+		"$[?length(@.a) == 1]",
+		"$[?count(@.*) == 1]",
+		"$[?count(@..a) == 1]",
+		"$[?match(@.a, 'a.*')]",
+		"$[?search(@.a, $.b)]",
+		"$[?!match(@.a, 'a')]",
+		"$[?value(@..a) == 1]",
+		"$[?length(value(@.*)) == 1]",
+		"$[?@.a == $.b]",
+		"$[? @.a ]",
+		"$[?\t@\n==\r1]",
+	}
+	for _, query := range queries {
+		if _, err := Compile(query); err != nil {
+			t.Error("jsonpath.Compile() returns an error for " + query)
+			t.Errorf("Expecting %v, got %v", nil, err)
+			return
+		}
+	}
+}
+
+func TestCompile_invalid(t *testing.T) {
+	queries := []string{
+		"",
+		"a",
+		" $",
+		"$ ",
+		"$.",
+		"$. a",
+		"$.1",
+		"$..",
+		"$.. a",
+		"$[",
+		"$[]",
+		"$['a'",
+		"$['a\"]",
+		"$['\\\"']",
+		"$['\x01']",
+		"$['\\x']",
+		"$['\\uD83D']",
+		"$['\\uDE00']",
+		"$['\\u12']",
+		"$[01]",
+		"$[-0]",
+		"$[1.0]",
+		"$[9007199254740992]",
+		"$[-9007199254740992]",
+		"$[1:2:3:4]",
+		"$[a]",
+		"$[0,]",
+		"$[?]",
+		"$[?1]",
+		"$[?'a']",
+		"$[?true]",
+		"$[?@.a = 1]",
+		"$[?@.* == 1]",
+		"$[?@..a == 1]",
+		"$[?@['a', 'b'] == 1]",
+		"$[?!@.a == 1]",
+		"$[?@.a == 1 == 2]",
+		"$[?@.a === 1]",
+		"$[?(@.a]",
+		"$[?@.a == 01]",
+		"$[?@.a == 1.]",
+		"$[?@.a == 1e]",
+		"$[?@.a == True]",
+		"$[?length(@)]",
+		"$[?length(@.*) == 1]",
+		"$[?length(@, 1) == 1]",
+		"$[?length() == 1]",
+		"$[?length (@) == 1]",
+		"$[?count(1) == 1]",
+		"$[?count(@.a)]",
+		"$[?match(@.a, 'a') == true]",
+		"$[?match(@.a)]",
+		"$[?unknown(@)]",
+		"$[?value(1) == 1]",
+		"$[?length(match(@.a, 'a')) == 1]",
+		"$\xff",
+		"$.a\xff",
+	}
+	for _, query := range queries {
+		if _, err := Compile(query); !errors.Is(err, InvalidQueryError) {
+			t.Error("jsonpath.Compile() does not return an error for " + query)
+			t.Errorf("Expecting %v, got %v", InvalidQueryError, err)
+			return
+		}
+	}
+}
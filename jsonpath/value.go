@@ -0,0 +1,400 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package jsonpath
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"sort"
+
+	"github.com/with-go/standard/collection"
+	"github.com/with-go/standard/cycle"
+)
+
+// kind defines the JSON type of a value.
+type kind int
+
+const (
+	otherKind kind = iota
+	nullKind
+	booleanKind
+	numberKind
+	stringKind
+	arrayKind
+	objectKind
+)
+
+// nothingType is the type of nothing, which cannot be the type of any
+// value of a node.
+type nothingType int
+
+// nothing is the result of an expression that has no value, such as a
+// query that selects no node.
+const nothing nothingType = 0
+
+// The kindOf() function returns the JSON type of the given value.
+func kindOf(value interface{}) kind {
+	switch value.(type) {
+	case nil:
+		return nullKind
+	case json.Number:
+		return numberKind
+	case cycle.OrderedMap:
+		if isNil(value) {
+			return nullKind
+		}
+		return objectKind
+	}
+	switch reflection := reflect.ValueOf(value); reflection.Kind() {
+	case reflect.Bool:
+		return booleanKind
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return numberKind
+	case reflect.String:
+		return stringKind
+	case reflect.Slice, reflect.Array:
+		return arrayKind
+	case reflect.Map:
+		return objectKind
+	case reflect.Ptr, reflect.Interface:
+		if reflection.IsNil() {
+			return nullKind
+		}
+	}
+	return otherKind
+}
+
+// The childrenOf() function returns the elements of an array or the members
+// of an object, in the order of RFC 9535.
+func childrenOf(node Node) []Node {
+	switch kindOf(node.Value) {
+	case arrayKind:
+		reflection := reflect.ValueOf(node.Value)
+		children := make([]Node, reflection.Len())
+		for index := range children {
+			children[index] = node.child(index, reflection.Index(index).Interface())
+		}
+		return children
+	case objectKind:
+		if orderedMap, ok := node.Value.(cycle.OrderedMap); ok {
+			keys := orderedMap.Keys()
+			children := make([]Node, len(keys))
+			for index, key := range keys {
+				children[index] = node.child(collection.KeyString(key), orderedMap.Get(key))
+			}
+			return children
+		}
+		reflection := reflect.ValueOf(node.Value)
+		keys := reflection.MapKeys()
+		names := make([]string, len(keys))
+		for index, key := range keys {
+			names[index] = collection.KeyString(key.Interface())
+		}
+		sort.Sort(byName{keys: keys, names: names})
+		children := make([]Node, len(keys))
+		for index, key := range keys {
+			children[index] = node.child(names[index], reflection.MapIndex(key).Interface())
+		}
+		return children
+	}
+	return nil
+}
+
+// The elementOf() function returns the element at the given index of an
+// array, counting back from the end for a negative index.
+func elementOf(node Node, index int) (Node, bool) {
+	if kindOf(node.Value) != arrayKind {
+		return Node{}, false
+	}
+	reflection := reflect.ValueOf(node.Value)
+	if index < 0 {
+		index += reflection.Len()
+	}
+	if index < 0 || index >= reflection.Len() {
+		return Node{}, false
+	}
+	return node.child(index, reflection.Index(index).Interface()), true
+}
+
+// The memberOf() function returns the member with the given name of an
+// object.
+func memberOf(node Node, name string) (Node, bool) {
+	if kindOf(node.Value) != objectKind {
+		return Node{}, false
+	}
+	if orderedMap, ok := node.Value.(cycle.OrderedMap); ok {
+		if hasser, ok := orderedMap.(interface{ Has(key interface{}) bool }); ok && hasser.Has(name) {
+			return node.child(name, orderedMap.Get(name)), true
+		}
+		for _, key := range orderedMap.Keys() {
+			if collection.KeyString(key) == name {
+				return node.child(name, orderedMap.Get(key)), true
+			}
+		}
+		return Node{}, false
+	}
+	reflection := reflect.ValueOf(node.Value)
+	if keyType := reflection.Type().Key(); keyType.Kind() == reflect.String {
+		value := reflection.MapIndex(reflect.ValueOf(name).Convert(keyType))
+		if !value.IsValid() {
+			return Node{}, false
+		}
+		return node.child(name, value.Interface()), true
+	}
+	for _, key := range reflection.MapKeys() {
+		if collection.KeyString(key.Interface()) == name {
+			return node.child(name, reflection.MapIndex(key).Interface()), true
+		}
+	}
+	return Node{}, false
+}
+
+// The lengthOf() function returns the number of elements of an array or
+// members of an object.
+func lengthOf(value interface{}) int {
+	if orderedMap, ok := value.(cycle.OrderedMap); ok {
+		return len(orderedMap.Keys())
+	}
+	return reflect.ValueOf(value).Len()
+}
+
+// The equal() function determines whether two values are equal, using the
+// comparison of RFC 9535.
+func equal(a interface{}, b interface{}) bool {
+	return (&equality{}).equal(a, b)
+}
+
+// equality keeps the pairs of arrays and objects that are being compared,
+// so two circular values are compared only once. The zero value is ready
+// to use.
+type equality struct {
+	active map[pair]bool
+}
+
+// pair identifies the memory of two arrays or objects being compared.
+type pair struct {
+	a reference
+	b reference
+}
+
+// reference identifies the memory of a value. A slice is identified by its
+// length as well, the same way as "cycle" package does.
+type reference struct {
+	pointer uintptr
+	length  int
+}
+
+func (equality *equality) equal(a interface{}, b interface{}) bool {
+	if a == nothing || b == nothing {
+		return a == b
+	}
+	kindOfA := kindOf(a)
+	if kindOfA != kindOf(b) {
+		return false
+	}
+	switch kindOfA {
+	case nullKind:
+		return true
+	case booleanKind:
+		return reflect.ValueOf(a).Bool() == reflect.ValueOf(b).Bool()
+	case numberKind:
+		result, ok := compareNumbers(a, b)
+		return ok && result == 0
+	case stringKind:
+		return reflect.ValueOf(a).String() == reflect.ValueOf(b).String()
+	}
+	// A pair that is already being compared is treated as equal, since any
+	// difference is found by the comparison in progress.
+	key, ok := pairOf(a, b)
+	if ok {
+		if equality.active[key] {
+			return true
+		}
+		if equality.active == nil {
+			equality.active = make(map[pair]bool)
+		}
+		equality.active[key] = true
+		defer delete(equality.active, key)
+	}
+	switch kindOfA {
+	case arrayKind:
+		childrenOfA, childrenOfB := childrenOf(Node{Value: a}), childrenOf(Node{Value: b})
+		if len(childrenOfA) != len(childrenOfB) {
+			return false
+		}
+		for index := range childrenOfA {
+			if !equality.equal(childrenOfA[index].Value, childrenOfB[index].Value) {
+				return false
+			}
+		}
+		return true
+	case objectKind:
+		childrenOfA, childrenOfB := childrenOf(Node{Value: a}), childrenOf(Node{Value: b})
+		if len(childrenOfA) != len(childrenOfB) {
+			return false
+		}
+		for _, child := range childrenOfA {
+			member, ok := memberOf(Node{Value: b}, child.Path[0].(string))
+			if !ok || !equality.equal(child.Value, member.Value) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// The pairOf() function returns the pair identifying the memory of two
+// arrays or objects. It returns false if either value is not held by a
+// pointer, a map or a slice, which cannot be circular.
+func pairOf(a interface{}, b interface{}) (pair, bool) {
+	referenceOfA, ok := referenceOf(a)
+	if !ok {
+		return pair{}, false
+	}
+	referenceOfB, ok := referenceOf(b)
+	if !ok {
+		return pair{}, false
+	}
+	return pair{a: referenceOfA, b: referenceOfB}, true
+}
+
+func referenceOf(value interface{}) (reference, bool) {
+	reflection := reflect.ValueOf(value)
+	switch reflection.Kind() {
+	case reflect.Map, reflect.Ptr:
+		if reflection.IsNil() {
+			return reference{}, false
+		}
+		return reference{pointer: reflection.Pointer()}, true
+	case reflect.Slice:
+		if reflection.Len() == 0 {
+			return reference{}, false
+		}
+		return reference{pointer: reflection.Pointer(), length: reflection.Len()}, true
+	}
+	return reference{}, false
+}
+
+// The less() function determines whether a value is less than another
+// value, which is only the case for two numbers or two strings.
+func less(a interface{}, b interface{}) bool {
+	if a == nothing || b == nothing {
+		return false
+	}
+	kindOfA := kindOf(a)
+	if kindOfA != kindOf(b) {
+		return false
+	}
+	switch kindOfA {
+	case numberKind:
+		result, ok := compareNumbers(a, b)
+		return ok && result < 0
+	case stringKind:
+		return reflect.ValueOf(a).String() < reflect.ValueOf(b).String()
+	}
+	return false
+}
+
+// number holds a number as an int64 or a float64, so integers are compared
+// without losing precision.
+type number struct {
+	isFloat bool
+	integer int64
+	float   float64
+}
+
+// The numberOf() function converts a number to a number{}. An unsigned
+// integer that does not fit an int64 is converted to a float64.
+func numberOf(value interface{}) (number, bool) {
+	if value, ok := value.(json.Number); ok {
+		if integer, err := value.Int64(); err == nil {
+			return number{integer: integer}, true
+		}
+		float, err := value.Float64()
+		return number{isFloat: true, float: float}, err == nil
+	}
+	switch reflection := reflect.ValueOf(value); reflection.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return number{integer: reflection.Int()}, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if reflection.Uint() > math.MaxInt64 {
+			return number{isFloat: true, float: float64(reflection.Uint())}, true
+		}
+		return number{integer: int64(reflection.Uint())}, true
+	case reflect.Float32, reflect.Float64:
+		return number{isFloat: true, float: reflection.Float()}, true
+	}
+	return number{}, false
+}
+
+// The compareNumbers() function returns -1, 0 or 1 if the first number is
+// less than, equal to, or greater than the second number. It returns false
+// if they cannot be compared, such as when either of them is NaN.
+func compareNumbers(a interface{}, b interface{}) (int, bool) {
+	numberOfA, ok := numberOf(a)
+	if !ok {
+		return 0, false
+	}
+	numberOfB, ok := numberOf(b)
+	if !ok {
+		return 0, false
+	}
+	if !numberOfA.isFloat && !numberOfB.isFloat {
+		switch {
+		case numberOfA.integer < numberOfB.integer:
+			return -1, true
+		case numberOfA.integer > numberOfB.integer:
+			return 1, true
+		}
+		return 0, true
+	}
+	floatOfA, floatOfB := numberOfA.toFloat(), numberOfB.toFloat()
+	switch {
+	case floatOfA < floatOfB:
+		return -1, true
+	case floatOfA > floatOfB:
+		return 1, true
+	case floatOfA == floatOfB:
+		return 0, true
+	}
+	return 0, false
+}
+
+// The toFloat() function returns the number as a float64.
+func (number number) toFloat() float64 {
+	if number.isFloat {
+		return number.float
+	}
+	return float64(number.integer)
+}
+
+// byName sorts the keys of a map by their names.
+type byName struct {
+	keys  []reflect.Value
+	names []string
+}
+
+func (sorter byName) Len() int {
+	return len(sorter.keys)
+}
+
+func (sorter byName) Less(i int, j int) bool {
+	return sorter.names[i] < sorter.names[j]
+}
+
+func (sorter byName) Swap(i int, j int) {
+	sorter.keys[i], sorter.keys[j] = sorter.keys[j], sorter.keys[i]
+	sorter.names[i], sorter.names[j] = sorter.names[j], sorter.names[i]
+}
+
+func isNil(value interface{}) bool {
+	reflection := reflect.ValueOf(value)
+	return reflection.Kind() == reflect.Ptr && reflection.IsNil()
+}
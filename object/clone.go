@@ -0,0 +1,35 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package object
+
+import (
+	"github.com/with-go/standard/clone"
+)
+
+// The Clone() function returns a shallow copy of the Object, which holds the
+// same elements as the Object in a new map. A nested Array, Object or
+// Collection is shared with the Object. Use DeepClone() function to copy
+// them as well.
+func (object Object) Clone() Object {
+	cloned := make(Object, len(object))
+	for key, value := range object {
+		cloned[key] = value
+	}
+	return cloned
+}
+
+// The DeepClone() function returns a deep copy of the Object, which also
+// copies the nested Arrays, Objects, Collections, native slices and native
+// maps, the same way as the Deep() function of "clone" package does. An
+// element that appears more than once in the Object, including an element
+// that holds the Object itself, is copied only once, so the copy keeps the
+// same shared and circular references.
+func (object Object) DeepClone() Object {
+	if object == nil {
+		return New()
+	}
+	return clone.Deep(object).(Object)
+}
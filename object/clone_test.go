@@ -0,0 +1,61 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package object
+
+import (
+	"testing"
+)
+
+func TestObject_Clone(t *testing.T) {
+	nested := New().Set("a", 1)
+	object := New().Set("b", "c").Set("nested", nested)
+	cloned := object.Clone()
+	cloned["b"] = "d"
+	if object["b"] != "c" {
+		t.Error("object.Clone() value is shared with the original")
+		t.Errorf("Expecting %v, got %v", "c", object["b"])
+		return
+	}
+	cloned["nested"].(Object)["a"] = 2
+	if nested["a"] != 2 {
+		t.Error("object.Clone() nested value does not match")
+		t.Errorf("Expecting %v, got %v", 2, nested["a"])
+		return
+	}
+}
+
+func TestObject_DeepClone(t *testing.T) {
+	nested := New().Set("a", 1)
+	object := New().Set("first", nested).Set("second", nested).Set("slice", []interface{}{2})
+	object["self"] = object
+	cloned := object.DeepClone()
+	if cloned.String() != object.String() {
+		t.Error("object.DeepClone() value does not match")
+		t.Errorf("Expecting %v, got %v", object.String(), cloned.String())
+		return
+	}
+	cloned["first"].(Object)["a"] = 3
+	cloned["slice"].([]interface{})[0] = 4
+	if nested["a"] != 1 || object["slice"].([]interface{})[0] != 2 {
+		t.Error("object.DeepClone() value is shared with the original")
+		t.Errorf("Got %v", object.String())
+		return
+	}
+	if cloned["second"].(Object)["a"] != 3 {
+		t.Error("object.DeepClone() shared reference does not match")
+		t.Errorf("Expecting %v, got %v", 3, cloned["second"].(Object)["a"])
+		return
+	}
+	cloned["self"].(Object)["b"] = 5
+	if cloned["b"] != 5 || object["b"] != nil {
+		t.Error("object.DeepClone() circular reference does not match")
+		return
+	}
+	if Object(nil).DeepClone() == nil {
+		t.Error("object.DeepClone() empty value does not match")
+		return
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package object
+
+// EventKind defines the kind of operation that changed an Object.
+type EventKind int
+
+const (
+	// AddEvent is emitted when a new element is added. The OldValue is nil.
+	AddEvent EventKind = iota
+	// UpdateEvent is emitted when the value of an existing element changes.
+	UpdateEvent
+	// DeleteEvent is emitted when an element is removed. The NewValue is nil.
+	DeleteEvent
+	// ClearEvent is emitted when every element is removed by the Clear()
+	// function. The Key is empty, and the OldValue and NewValue are nil.
+	ClearEvent
+)
+
+// The String() function returns the name of the EventKind.
+func (kind EventKind) String() string {
+	switch kind {
+	case AddEvent:
+		return "add"
+	case UpdateEvent:
+		return "update"
+	case DeleteEvent:
+		return "delete"
+	case ClearEvent:
+		return "clear"
+	}
+	return "unknown"
+}
+
+// Event describes a single change of an Object, or of an Object nested
+// inside it.
+type Event struct {
+	// Kind is the kind of operation.
+	Kind EventKind
+	// Key is the key of the changed element, inside the Object that
+	// actually changed.
+	Key string
+	// Path is the list of keys from the observed Object down to the
+	// changed element. For a change of the observed Object itself, it
+	// only holds the Key. For a ClearEvent, it does not hold the Key.
+	Path []string
+	// OldValue is the value before the change.
+	OldValue interface{}
+	// NewValue is the value after the change.
+	NewValue interface{}
+}
+
+// The Observe() function returns a new Observable that wraps the Object.
+// Because an Object is a native map, which cannot hold its handlers, only
+// the changes made through the Observable can be observed.
+func (object Object) Observe() *Observable {
+	if object == nil {
+		object = New()
+	}
+	return &Observable{object: object}
+}
+
+// Observable wraps an Object, and reports every change made through its
+// Set(), Delete() and Clear() functions to the handlers registered using
+// OnChange() function. A change made directly to the Object, such as
+// object[key] = value, cannot be observed. The same as a Collection, an
+// Observable is not safe for concurrent use.
+type Observable struct {
+	object   Object
+	handlers []*ChangeFunc
+	batching int
+	pending  []Event
+	// parent and key link an Observable returned by Nested() function to
+	// the Observable whose Object holds its Object.
+	parent *Observable
+	key    string
+	// children holds the Observables returned by Nested() function.
+	children map[string]*Observable
+}
+
+// The Batch() function calls the given function, and delivers every event
+// emitted by the Observable while the function runs to the handlers in a
+// single call once it returns, instead of a call for each event. Batches
+// can be nested, in which case the events are delivered once the outermost
+// batch returns.
+func (observable *Observable) Batch(function func()) *Observable {
+	observable.batching++
+	defer func() {
+		observable.batching--
+		if observable.batching == 0 && len(observable.pending) > 0 {
+			events := observable.pending
+			observable.pending = nil
+			observable.deliver(events)
+		}
+	}()
+	function()
+	return observable
+}
+
+// The Clear() function removes all elements from the Object, the same way
+// as Object.Clear() function does, and emits a ClearEvent if the Object
+// was not empty.
+func (observable *Observable) Clear() *Observable {
+	if len(observable.object) == 0 {
+		return observable
+	}
+	observable.object.Clear()
+	observable.children = nil
+	observable.emit(ClearEvent, "", nil, nil)
+	return observable
+}
+
+// The Delete() function removes the specified element from the Object by
+// key, the same way as Object.Delete() function does, and emits a
+// DeleteEvent if the element existed.
+func (observable *Observable) Delete(key string) *Observable {
+	if value, exists := observable.object[key]; exists {
+		observable.object.Delete(key)
+		delete(observable.children, key)
+		observable.emit(DeleteEvent, key, value, nil)
+	}
+	return observable
+}
+
+// The Nested() function returns an Observable that wraps the Object held
+// by the element with the specified key. The changes made through it are
+// reported to its own handlers, and to the handlers of this Observable
+// with the key prepended to the Path, until the element is changed or
+// removed through this Observable. If the element is not an Object, it
+// will returns nil.
+func (observable *Observable) Nested(key string) *Observable {
+	if child, ok := observable.children[key]; ok {
+		return child
+	}
+	object, ok := observable.object[key].(Object)
+	if !ok || object == nil {
+		return nil
+	}
+	if observable.children == nil {
+		observable.children = make(map[string]*Observable)
+	}
+	child := &Observable{object: object, parent: observable, key: key}
+	observable.children[key] = child
+	return child
+}
+
+// The Object() function returns the Object wrapped by the Observable.
+func (observable *Observable) Object() Object {
+	return observable.object
+}
+
+// The OnChange() function registers a handler that is called with the
+// events of every change made through the Observable, including the
+// changes made through the Observables returned by Nested() function at
+// any depth, and returns a function that unregisters the handler. Outside
+// of Batch() function, the handler is called once for each event.
+func (observable *Observable) OnChange(handler ChangeFunc) func() {
+	registered := &handler
+	observable.handlers = append(observable.handlers, registered)
+	return func() {
+		for index, candidate := range observable.handlers {
+			if candidate == registered {
+				observable.handlers = append(observable.handlers[:index:index], observable.handlers[index+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// The Set() function adds or updates an element with a specified key and
+// value to the Object, the same way as Object.Set() function does, and
+// emits an AddEvent or an UpdateEvent.
+func (observable *Observable) Set(key string, value interface{}) *Observable {
+	oldValue, exists := observable.object[key]
+	observable.object.Set(key, value)
+	delete(observable.children, key)
+	if exists {
+		observable.emit(UpdateEvent, key, oldValue, value)
+	} else {
+		observable.emit(AddEvent, key, nil, value)
+	}
+	return observable
+}
+
+// The emit() function reports a change of the Object to the handlers of
+// the Observable, and to the handlers of every Observable that holds it.
+func (observable *Observable) emit(kind EventKind, key string, oldValue interface{}, newValue interface{}) {
+	event := Event{Kind: kind, Key: key, OldValue: oldValue, NewValue: newValue}
+	if kind != ClearEvent {
+		event.Path = []string{key}
+	}
+	observable.notify(event)
+}
+
+func (observable *Observable) notify(event Event) {
+	if observable.batching > 0 {
+		observable.pending = append(observable.pending, event)
+	} else if len(observable.handlers) > 0 {
+		observable.deliver([]Event{event})
+	}
+	parent := observable.parent
+	if parent == nil || parent.children[observable.key] != observable {
+		return
+	}
+	deep := event
+	deep.Path = append([]string{observable.key}, event.Path...)
+	parent.notify(deep)
+}
+
+func (observable *Observable) deliver(events []Event) {
+	handlers := make([]*ChangeFunc, len(observable.handlers))
+	copy(handlers, observable.handlers)
+	for _, handler := range handlers {
+		(*handler)(events)
+	}
+}
+
+type ChangeFunc func (events []Event)
@@ -0,0 +1,111 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package object
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestObject_Observe(t *testing.T) {
+	object := New().Set("a", 1)
+	observable := object.Observe()
+	observable.Set("b", 2)
+	if observable.Object().Get("b") != 2 || object.Get("b") != 2 {
+		t.Error("object.Observe() does not wrap the Object")
+		t.Errorf("Expecting %v, got %v", 2, object.Get("b"))
+		return
+	}
+	var nilObject Object
+	if nilObject.Observe().Object() == nil {
+		t.Error("object.Observe() on a nil Object does not wrap an empty Object")
+		return
+	}
+}
+
+func TestObservable_Batch(t *testing.T) {
+	observable := New().Observe()
+	var calls [][]Event
+	unsubscribe := observable.OnChange(func(events []Event) {
+		calls = append(calls, events)
+	})
+	defer unsubscribe()
+	observable.Batch(func() {
+		observable.Set("a", 1).Batch(func() {
+			observable.Set("b", 2)
+		})
+	})
+	if len(calls) != 1 || len(calls[0]) != 2 {
+		t.Error("observable.Batch() does not deliver the events in a single call")
+		t.Errorf("Expecting %v, got %v", 1, len(calls))
+		return
+	}
+}
+
+func TestObservable_Nested(t *testing.T) {
+	inner := New().Set("x", 1)
+	observable := New().Set("inner", inner).Set("value", 1).Observe()
+	if observable.Nested("value") != nil || observable.Nested("missing") != nil {
+		t.Error("observable.Nested() does not return nil for a non-Object element")
+		return
+	}
+	nested := observable.Nested("inner")
+	if nested != observable.Nested("inner") {
+		t.Error("observable.Nested() does not return the same Observable")
+		return
+	}
+	var events []Event
+	observable.OnChange(func(delivered []Event) {
+		events = append(events, delivered...)
+	})
+	nested.Set("x", 2)
+	expecting := []Event{
+		{UpdateEvent, "x", []string{"inner", "x"}, 1, 2},
+	}
+	if !reflect.DeepEqual(events, expecting) {
+		t.Error("observable.Nested() events does not match")
+		t.Errorf("Expecting %v, got %v", expecting, events)
+		return
+	}
+	observable.Set("inner", New())
+	events = nil
+	nested.Set("x", 3)
+	if len(events) != 0 {
+		t.Error("observable.Nested() keeps reporting after the element is replaced")
+		t.Errorf("Expecting %v, got %v", 0, len(events))
+		return
+	}
+}
+
+func TestObservable_OnChange(t *testing.T) {
+	observable := New().Set("a", 1).Observe()
+	other := New().Observe()
+	var events []Event
+	unsubscribe := observable.OnChange(func(delivered []Event) {
+		events = append(events, delivered...)
+	})
+	observable.Set("a", 10).Set("b", 2).Delete("b").Delete("missing")
+	other.Set("a", 1)
+	observable.Clear().Clear()
+	expecting := []Event{
+		{UpdateEvent, "a", []string{"a"}, 1, 10},
+		{AddEvent, "b", []string{"b"}, nil, 2},
+		{DeleteEvent, "b", []string{"b"}, 2, nil},
+		{ClearEvent, "", nil, nil, nil},
+	}
+	if !reflect.DeepEqual(events, expecting) {
+		t.Error("observable.OnChange() events does not match")
+		t.Errorf("Expecting %v, got %v", expecting, events)
+		return
+	}
+	unsubscribe()
+	observable.Set("c", 3)
+	if len(events) != len(expecting) {
+		t.Error("observable.OnChange() handler is kept after unsubscribing")
+		t.Errorf("Expecting %v, got %v", len(expecting), len(events))
+		return
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package object
+
+import (
+	"fmt"
+
+	"github.com/with-go/standard/pretty"
+)
+
+// The Format() function implements fmt.Formatter. The %v verb prints the
+// same compact string as String() function, the %+v verb prints indented
+// multi-line output using the "pretty" package, and the %#v verb prints the
+// Go source that rebuilds the Object.
+func (object Object) Format(state fmt.State, verb rune) {
+	pretty.Format(state, verb, object, map[string]interface{}(object))
+}
+
+// The GoString() function returns the Go source that rebuilds the Object,
+// such as object.Object{"a": 1}, with the keys sorted alphabetically.
+func (object Object) GoString() string {
+	if object == nil {
+		return "object.Object{}"
+	}
+	return pretty.GoString(object)
+}
@@ -0,0 +1,38 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package object
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestObject_Format(t *testing.T) {
+	object := New().Set("b", 1).Set("a", New().Set("c", "d"))
+	tests := []struct {
+		format   string
+		expected string
+	}{
+		{"%v", "{\"a\":{\"c\":\"d\"},\"b\":1}"},
+		{"%+v", "{\n  \"a\": {\n    \"c\": \"d\"\n  },\n  \"b\": 1\n}"},
+		{"%#v", "object.Object{\"a\": object.Object{\"c\": \"d\"}, \"b\": 1}"},
+	}
+	for _, test := range tests {
+		if result := fmt.Sprintf(test.format, object); result != test.expected {
+			t.Error("object.Format() value does not match")
+			t.Errorf("Expecting %q, got %q", test.expected, result)
+			return
+		}
+	}
+}
+
+func TestObject_GoString(t *testing.T) {
+	if result := New().GoString(); result != "object.Object{}" {
+		t.Error("object.GoString() value does not match")
+		t.Errorf("Expecting %v, got %v", "object.Object{}", result)
+		return
+	}
+}
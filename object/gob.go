@@ -0,0 +1,60 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package object
+
+import (
+	"bytes"
+	"encoding/gob"
+
+	"github.com/with-go/standard/cycle"
+)
+
+func init() {
+	// Registering the types allows them to be sent as interface{} values,
+	// which is how nested elements are encoded.
+	gob.Register(Object{})
+	gob.Register([]interface{}{})
+	gob.Register(map[string]interface{}{})
+}
+
+// The GobEncode() function implements the gob.GobEncoder interface. Each
+// value is encoded as an interface{} value, so nested Arrays, Objects,
+// Collections and common scalar types keep their type when decoded. Any
+// other value type has to be registered using gob.Register(). A circular
+// reference cannot be encoded, so it will returns a cycle.PathError.
+func (object Object) GobEncode() ([]byte, error) {
+	if err := cycle.Check(object); err != nil {
+		return nil, err
+	}
+	buffer := &bytes.Buffer{}
+	if err := gob.NewEncoder(buffer).Encode(map[string]interface{}(object)); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// The GobDecode() function implements the gob.GobDecoder interface. It
+// replaces the Object with the decoded elements.
+func (object *Object) GobDecode(data []byte) error {
+	values := make(map[string]interface{})
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&values); err != nil {
+		return err
+	}
+	*object = NewFromMap(values)
+	return nil
+}
+
+// The MarshalBinary() function implements the encoding.BinaryMarshaler
+// interface. It returns the same data as the GobEncode() function.
+func (object Object) MarshalBinary() ([]byte, error) {
+	return object.GobEncode()
+}
+
+// The UnmarshalBinary() function implements the encoding.BinaryUnmarshaler
+// interface. It accepts the data returned by the MarshalBinary() function.
+func (object *Object) UnmarshalBinary(data []byte) error {
+	return object.GobDecode(data)
+}
@@ -0,0 +1,60 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package object
+
+import (
+	"bytes"
+	"encoding/gob"
+	"reflect"
+	"testing"
+)
+
+func TestObject_GobEncode(t *testing.T) {
+	object := New().
+		Set("int", 1).
+		Set("float", float32(1.5)).
+		Set("nil", nil).
+		Set("child", New().Set("name", "a")).
+		Set("slice", []interface{}{"b", 2})
+	buffer := &bytes.Buffer{}
+	if err := gob.NewEncoder(buffer).Encode(object); err != nil {
+		t.Error("gob.Encoder.Encode(object) failed to encode")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	var decoded Object
+	if err := gob.NewDecoder(buffer).Decode(&decoded); err != nil {
+		t.Error("gob.Decoder.Decode(object) failed to decode")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	if !reflect.DeepEqual(decoded, object) {
+		t.Error("object.GobEncode() does not round-trip")
+		t.Errorf("Expecting %#v, got %#v", object, decoded)
+		return
+	}
+}
+
+func TestObject_MarshalBinary(t *testing.T) {
+	object := New().Set("a", "b")
+	data, err := object.MarshalBinary()
+	if err != nil {
+		t.Error("object.MarshalBinary() failed to encode")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	decoded := New().Set("stale", true)
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Error("object.UnmarshalBinary(data) failed to decode")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	if !reflect.DeepEqual(decoded, object) {
+		t.Error("object.UnmarshalBinary(data) does not round-trip")
+		t.Errorf("Expecting %v, got %v", object, decoded)
+		return
+	}
+}
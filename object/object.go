@@ -10,6 +10,9 @@ import (
 	"reflect"
 	"sort"
 	"strings"
+
+	"github.com/with-go/standard/cycle"
+	"github.com/with-go/standard/pretty"
 )
 
 // The New() function creates a new Object.
@@ -167,9 +170,13 @@ func (object Object) Set(key string, value interface{}) Object {
 }
 
 // The String() function returns a string representing the specified Object
-// and its elements.
+// and its elements. If the Object holds itself, the circular element is
+// printed as the Marker of "cycle" package.
 func (object Object) String() string {
-	b, _ := json.Marshal(object)
+	b, err := json.Marshal(object)
+	if err != nil && cycle.Check(object) != nil {
+		return pretty.Compact(object)
+	}
 	return string(b)
 }
 
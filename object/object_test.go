@@ -343,6 +343,16 @@ func TestObject_String(t *testing.T) {
 	}
 }
 
+func TestObject_String_circular(t *testing.T) {
+	object := New().Set("a", 1)
+	object.Set("self", object)
+	if object.String() != "{\"a\":1,\"self\":[Circular]}" {
+		t.Error("object.String() does not return the expected circular string")
+		t.Errorf("Expecting %s, got %s", "{\"a\":1,\"self\":[Circular]}", object.String())
+		return
+	}
+}
+
 func TestObject_Values(t *testing.T) {
 	resetTestObject()
 	object := testObject
@@ -0,0 +1,283 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package persistent
+
+import (
+	"errors"
+
+	"github.com/with-go/standard/array"
+)
+
+var (
+	IndexOutOfRangeError = errors.New("the given index is out of the range of the Array")
+)
+
+const (
+	// branchBits is the number of index or hash bits used by each level of
+	// a trie, so each node has up to 32 branches.
+	branchBits  = 5
+	branchWidth = 1 << branchBits
+	branchMask  = branchWidth - 1
+)
+
+// The NewArray() function creates a new Array that holds the given values.
+func NewArray(values ...interface{}) Array {
+	created := Array{}
+	for _, value := range values {
+		created = created.Push(value)
+	}
+	return created
+}
+
+// The ArrayOf() function creates a new Array that holds the elements of the
+// given untyped Array.
+func ArrayOf(v array.Array) Array {
+	return NewArray(v...)
+}
+
+// Array defines an immutable Array. See "persistent" package documentation
+// for more information.
+type Array struct {
+	length int
+	shift  uint
+	root   *vectorNode
+	// tail holds the last elements, up to 32, outside of the trie, so a
+	// Push() or Pop() at the end usually does not touch the trie.
+	tail []interface{}
+}
+
+// vectorNode is a node of the trie. A leaf node only holds values, and an
+// internal node only holds children.
+type vectorNode struct {
+	children []*vectorNode
+	values   []interface{}
+}
+
+// The ForEach() function executes a provided function once for each Array
+// element, in index order.
+func (array Array) ForEach(function ArrayForEachFunc) {
+	for index := 0; index < array.length; {
+		// Every leaf node is full, and the tail only holds the last elements.
+		for _, value := range array.leafFor(index) {
+			function(index, value)
+			index++
+		}
+	}
+}
+
+// The Get() function returns the element at the given index. If the index
+// is out of range, it will returns nil.
+func (array Array) Get(index int) interface{} {
+	if index < 0 || index >= array.length {
+		return nil
+	}
+	return array.leafFor(index)[index&branchMask]
+}
+
+// The Length() function returns the number of elements contained inside the
+// Array.
+func (array Array) Length() int {
+	return array.length
+}
+
+// The Pop() function returns a new Array without the last element, and that
+// last element. If the Array is empty, it will returns the same Array and
+// nil.
+func (array Array) Pop() (Array, interface{}) {
+	if array.length == 0 {
+		return array, nil
+	}
+	last := array.Get(array.length - 1)
+	if array.length == 1 {
+		return Array{}, last
+	}
+	popped := array
+	popped.length--
+	if array.length-array.tailOffset() > 1 {
+		popped.tail = array.tail[:len(array.tail)-1]
+		return popped, last
+	}
+	popped.tail = array.leafFor(array.length - 2)
+	root := array.popTail(array.shift, array.root)
+	if root == nil {
+		root = &vectorNode{children: make([]*vectorNode, branchWidth)}
+	}
+	if array.shift > branchBits && root.children[1] == nil {
+		root = root.children[0]
+		popped.shift -= branchBits
+	}
+	popped.root = root
+	return popped, last
+}
+
+// The Push() function returns a new Array with the given values added to
+// the end of the Array.
+func (array Array) Push(values ...interface{}) Array {
+	for _, value := range values {
+		array = array.push(value)
+	}
+	return array
+}
+
+// The Set() function returns a new Array with the element at the given
+// index replaced by the given value. Setting the index right after the last
+// element is the same as Push() function. Any other index out of range
+// panics with IndexOutOfRangeError.
+func (array Array) Set(index int, value interface{}) Array {
+	if index == array.length {
+		return array.push(value)
+	}
+	if index < 0 || index > array.length {
+		panic(IndexOutOfRangeError)
+	}
+	updated := array
+	if index >= array.tailOffset() {
+		updated.tail = make([]interface{}, len(array.tail))
+		copy(updated.tail, array.tail)
+		updated.tail[index&branchMask] = value
+		return updated
+	}
+	updated.root = setIn(array.shift, array.root, index, value)
+	return updated
+}
+
+// The String() function returns a string representing the Array and its
+// elements.
+func (array Array) String() string {
+	return array.Untyped().String()
+}
+
+// The Untyped() function returns a new array.Array that holds the elements
+// of the Array. Changes to the returned Array will not be reflected to the
+// Array.
+func (array Array) Untyped() array.Array {
+	return array.Values()
+}
+
+// The Values() function returns a new slice that contains the elements of
+// the Array.
+func (array Array) Values() []interface{} {
+	values := make([]interface{}, 0, array.length)
+	array.ForEach(func(index int, value interface{}) {
+		values = append(values, value)
+	})
+	return values
+}
+
+// The tailOffset() function returns the index of the first element held by
+// the tail.
+func (array Array) tailOffset() int {
+	if array.length < branchWidth {
+		return 0
+	}
+	return ((array.length - 1) >> branchBits) << branchBits
+}
+
+// The leafFor() function returns the values of the leaf node, or the tail,
+// that holds the element at the given index.
+func (array Array) leafFor(index int) []interface{} {
+	if index >= array.tailOffset() {
+		return array.tail
+	}
+	node := array.root
+	for level := array.shift; level > 0; level -= branchBits {
+		node = node.children[(index>>level)&branchMask]
+	}
+	return node.values
+}
+
+func (array Array) push(value interface{}) Array {
+	pushed := array
+	pushed.length++
+	if array.length-array.tailOffset() < branchWidth {
+		pushed.tail = make([]interface{}, len(array.tail), len(array.tail)+1)
+		copy(pushed.tail, array.tail)
+		pushed.tail = append(pushed.tail, value)
+		return pushed
+	}
+	// The tail is full, so it is moved into the trie.
+	leaf := &vectorNode{values: array.tail}
+	if array.root == nil {
+		pushed.root, pushed.shift = &vectorNode{children: make([]*vectorNode, branchWidth)}, branchBits
+		pushed.root.children[0] = leaf
+	} else if array.length>>branchBits > 1<<array.shift {
+		pushed.root = &vectorNode{children: make([]*vectorNode, branchWidth)}
+		pushed.root.children[0] = array.root
+		pushed.root.children[1] = newPath(array.shift, leaf)
+		pushed.shift += branchBits
+	} else {
+		pushed.root = array.pushTail(array.shift, array.root, leaf)
+	}
+	pushed.tail = []interface{}{value}
+	return pushed
+}
+
+func (array Array) pushTail(level uint, parent *vectorNode, leaf *vectorNode) *vectorNode {
+	index := ((array.length - 1) >> level) & branchMask
+	node := parent.clone()
+	if level == branchBits {
+		node.children[index] = leaf
+	} else if child := parent.children[index]; child != nil {
+		node.children[index] = array.pushTail(level-branchBits, child, leaf)
+	} else {
+		node.children[index] = newPath(level-branchBits, leaf)
+	}
+	return node
+}
+
+func (array Array) popTail(level uint, node *vectorNode) *vectorNode {
+	index := ((array.length - 2) >> level) & branchMask
+	if level > branchBits {
+		child := array.popTail(level-branchBits, node.children[index])
+		if child == nil && index == 0 {
+			return nil
+		}
+		popped := node.clone()
+		popped.children[index] = child
+		return popped
+	}
+	if index == 0 {
+		return nil
+	}
+	popped := node.clone()
+	popped.children[index] = nil
+	return popped
+}
+
+func newPath(level uint, node *vectorNode) *vectorNode {
+	if level == 0 {
+		return node
+	}
+	path := &vectorNode{children: make([]*vectorNode, branchWidth)}
+	path.children[0] = newPath(level-branchBits, node)
+	return path
+}
+
+func setIn(level uint, node *vectorNode, index int, value interface{}) *vectorNode {
+	updated := node.clone()
+	if level == 0 {
+		updated.values[index&branchMask] = value
+		return updated
+	}
+	child := (index >> level) & branchMask
+	updated.children[child] = setIn(level-branchBits, node.children[child], index, value)
+	return updated
+}
+
+func (node *vectorNode) clone() *vectorNode {
+	cloned := &vectorNode{}
+	if node.children != nil {
+		cloned.children = make([]*vectorNode, branchWidth)
+		copy(cloned.children, node.children)
+	}
+	if node.values != nil {
+		cloned.values = make([]interface{}, len(node.values))
+		copy(cloned.values, node.values)
+	}
+	return cloned
+}
+
+type ArrayForEachFunc func (index int, value interface{})
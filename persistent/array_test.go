@@ -0,0 +1,137 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package persistent
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/with-go/standard/array"
+)
+
+// The elements of the test Arrays are big enough to use several trie levels.
+const testLength = 40000
+
+func TestNewArray(t *testing.T) {
+	created := NewArray(1, 2, 3)
+	if created.Length() != 3 || created.String() != "[1,2,3]" {
+		t.Error("NewArray() value does not match")
+		t.Errorf("Expecting %v, got %v", "[1,2,3]", created)
+		return
+	}
+	if converted := ArrayOf(array.New("a", "b")); !reflect.DeepEqual(converted.Untyped(), array.New("a", "b")) {
+		t.Error("ArrayOf() value does not match")
+		t.Errorf("Expecting %v, got %v", "[\"a\",\"b\"]", converted)
+		return
+	}
+}
+
+func TestArray_Get(t *testing.T) {
+	var created Array
+	for index := 0; index < testLength; index++ {
+		created = created.Push(index)
+	}
+	for index := 0; index < testLength; index++ {
+		if created.Get(index) != index {
+			t.Error("array.Get() value does not match")
+			t.Errorf("Expecting %v, got %v", index, created.Get(index))
+			return
+		}
+	}
+	if created.Get(-1) != nil || created.Get(testLength) != nil {
+		t.Error("array.Get() does not return nil for an index out of range")
+		t.Errorf("Expecting %v, got %v", nil, created.Get(testLength))
+		return
+	}
+}
+
+func TestArray_Pop(t *testing.T) {
+	versions := []Array{{}}
+	for index := 0; index < testLength; index++ {
+		versions = append(versions, versions[index].Push(index))
+	}
+	popped := versions[testLength]
+	for index := testLength - 1; index >= 0; index-- {
+		var last interface{}
+		popped, last = popped.Pop()
+		if last != index || popped.Length() != index {
+			t.Error("array.Pop() value does not match")
+			t.Errorf("Expecting %v, got %v", index, last)
+			return
+		}
+		// A popped Array has to keep working as a base for new versions
+		if index%1000 == 0 && !reflect.DeepEqual(popped.Push("x").Values(), versions[index].Push("x").Values()) {
+			t.Error("array.Pop() result does not match the pushed version")
+			t.Errorf("Expecting %v, got %v", index+1, popped.Push("x").Length())
+			return
+		}
+	}
+	if _, last := popped.Pop(); last != nil {
+		t.Error("array.Pop() does not return nil for an empty Array")
+		t.Errorf("Expecting %v, got %v", nil, last)
+		return
+	}
+}
+
+func TestArray_Push(t *testing.T) {
+	base := NewArray(1, 2, 3)
+	popped, _ := base.Pop()
+	first := popped.Push("first")
+	second := popped.Push("second")
+	if base.Get(2) != 3 || first.Get(2) != "first" || second.Get(2) != "second" {
+		t.Error("array.Push() changes another version")
+		t.Errorf("Expecting %v, got %v", 3, base.Get(2))
+		return
+	}
+}
+
+func TestArray_Set(t *testing.T) {
+	var original Array
+	for index := 0; index < testLength; index++ {
+		original = original.Push(index)
+	}
+	updated := original
+	for index := 0; index < testLength; index += 97 {
+		updated = updated.Set(index, -index)
+	}
+	for index := 0; index < testLength; index++ {
+		expecting := index
+		if index%97 == 0 {
+			expecting = -index
+		}
+		if original.Get(index) != index || updated.Get(index) != expecting {
+			t.Error("array.Set() value does not match")
+			t.Errorf("Expecting %v, got %v", expecting, updated.Get(index))
+			return
+		}
+	}
+	if appended := original.Set(testLength, "end"); appended.Length() != testLength+1 {
+		t.Error("array.Set() does not push at the index after the last element")
+		t.Errorf("Expecting %v, got %v", testLength+1, appended.Length())
+		return
+	}
+	defer func() {
+		if recovered := recover(); recovered != IndexOutOfRangeError {
+			t.Error("array.Set() does not panic with IndexOutOfRangeError")
+			t.Errorf("Expecting %v, got %v", IndexOutOfRangeError, recovered)
+		}
+	}()
+	original.Set(testLength+1, "out")
+}
+
+func TestArray_Values(t *testing.T) {
+	var created Array
+	expecting := make([]interface{}, 70)
+	for index := range expecting {
+		created = created.Push(index)
+		expecting[index] = index
+	}
+	if !reflect.DeepEqual(created.Values(), expecting) {
+		t.Error("array.Values() value does not match")
+		t.Errorf("Expecting %v, got %v", expecting, created.Values())
+		return
+	}
+}
@@ -0,0 +1,22 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+/*
+Package persistent provides immutable versions of the Array and Object types.
+A persistent value never changes: every update returns a new version, and the
+old version stays valid and unchanged. The new version shares most of its
+structure with the old one, so an update costs O(log n) instead of a full
+copy, and keeping an old version as a snapshot costs nothing.
+
+	v1 := persistent.NewArray(1, 2, 3)
+	v2 := v1.Set(0, 10).Push(4)
+	// v1 is still [1,2,3], v2 is [10,2,3,4]
+
+The Array is a bit-partitioned vector trie with a branching factor of 32, and
+the Object is a hash array mapped trie (HAMT). Their zero values are empty
+and ready to use, and they are safe for concurrent use because they are never
+modified.
+*/
+package persistent
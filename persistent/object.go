@@ -0,0 +1,304 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package persistent
+
+import (
+	"hash/fnv"
+	"math/bits"
+	"sort"
+
+	"github.com/with-go/standard/object"
+)
+
+// The NewObject() function creates a new empty Object.
+func NewObject() Object {
+	return Object{}
+}
+
+// The ObjectOf() function creates a new Object that holds the elements of
+// the given untyped Object.
+func ObjectOf(v object.Object) Object {
+	created := Object{}
+	for key, value := range v {
+		created = created.Set(key, value)
+	}
+	return created
+}
+
+// Object defines an immutable Object. See "persistent" package
+// documentation for more information.
+type Object struct {
+	length int
+	root   *hamtNode
+}
+
+// hamtNode is a node of the trie. Each entry is either a key-value pair or a
+// child node, and the bitmap tells which of the 32 slots of the node are
+// used. Once every bit of the hash is used, the node is a collision node
+// whose entries are key-value pairs with the same hash, and its bitmap is
+// not used.
+type hamtNode struct {
+	bitmap  uint32
+	entries []hamtEntry
+}
+
+type hamtEntry struct {
+	key   string
+	value interface{}
+	child *hamtNode
+}
+
+// maxShift is the shift of the last level that uses the bits of a hash.
+const maxShift = 30
+
+// The Delete() function returns a new Object without the element of the
+// given key. If the key does not exist, it will returns the same Object.
+func (object Object) Delete(key string) Object {
+	if object.root == nil {
+		return object
+	}
+	root, isDeleted := object.root.delete(0, hashOf(key), key)
+	if !isDeleted {
+		return object
+	}
+	return Object{object.length - 1, root}
+}
+
+// The ForEach() function executes a provided function once for each Object
+// element, in the alphabetical order of the keys, the same way as
+// object.Object.ForEach() function does.
+func (object Object) ForEach(function ObjectForEachFunc) {
+	for _, key := range object.Keys() {
+		function(key, object.Get(key))
+	}
+}
+
+// The Get() function returns the element of the given key. If the key does
+// not exist, it will returns nil.
+func (object Object) Get(key string) interface{} {
+	value, _ := object.Lookup(key)
+	return value
+}
+
+// The Has() function returns a boolean indicating whether an element with
+// the specified key exists or not.
+func (object Object) Has(key string) bool {
+	_, exists := object.Lookup(key)
+	return exists
+}
+
+// The Keys() function returns a slice of string that contains the keys for
+// each element in the Object, sorted alphabetically.
+func (object Object) Keys() []string {
+	keys := make([]string, 0, object.length)
+	object.root.walk(func(entry *hamtEntry) {
+		keys = append(keys, entry.key)
+	})
+	sort.Strings(keys)
+	return keys
+}
+
+// The Length() function returns the number of elements contained inside the
+// Object.
+func (object Object) Length() int {
+	return object.length
+}
+
+// The Lookup() function returns the element of the given key, and a boolean
+// indicating whether the key exists.
+func (object Object) Lookup(key string) (interface{}, bool) {
+	hash := hashOf(key)
+	node := object.root
+	for shift := uint(0); node != nil; shift += branchBits {
+		if shift > maxShift {
+			for _, entry := range node.entries {
+				if entry.key == key {
+					return entry.value, true
+				}
+			}
+			return nil, false
+		}
+		bit := uint32(1) << ((hash >> shift) & branchMask)
+		if node.bitmap&bit == 0 {
+			return nil, false
+		}
+		entry := node.entries[node.position(bit)]
+		if entry.child == nil {
+			if entry.key == key {
+				return entry.value, true
+			}
+			return nil, false
+		}
+		node = entry.child
+	}
+	return nil, false
+}
+
+// The Set() function returns a new Object with the element of the given key
+// added or updated.
+func (object Object) Set(key string, value interface{}) Object {
+	root, isAdded := object.root.set(0, hashOf(key), key, value)
+	if isAdded {
+		return Object{object.length + 1, root}
+	}
+	return Object{object.length, root}
+}
+
+// The String() function returns a string representing the Object and its
+// elements.
+func (object Object) String() string {
+	return object.Untyped().String()
+}
+
+// The Untyped() function returns a new object.Object that holds the elements
+// of the Object. Changes to the returned Object will not be reflected to the
+// Object.
+func (object Object) Untyped() object.Object {
+	untyped := make(map[string]interface{}, object.length)
+	object.root.walk(func(entry *hamtEntry) {
+		untyped[entry.key] = entry.value
+	})
+	return untyped
+}
+
+// The Values() function returns a slice that contains the values for each
+// element in the Object, ordered based on the keys that sorted
+// alphabetically.
+func (object Object) Values() []interface{} {
+	values := make([]interface{}, 0, object.length)
+	object.ForEach(func(key string, value interface{}) {
+		values = append(values, value)
+	})
+	return values
+}
+
+func hashOf(key string) uint32 {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(key))
+	return hash.Sum32()
+}
+
+// The position() function returns the index in the entries of the slot
+// represented by the given bit.
+func (node *hamtNode) position(bit uint32) int {
+	return bits.OnesCount32(node.bitmap & (bit - 1))
+}
+
+// The set() function returns a copy of the node with the given key set, and
+// whether the key was added. A nil node is treated as an empty node.
+func (node *hamtNode) set(shift uint, hash uint32, key string, value interface{}) (*hamtNode, bool) {
+	if node == nil {
+		node = &hamtNode{}
+	}
+	if shift > maxShift {
+		for index, entry := range node.entries {
+			if entry.key == key {
+				updated := node.withEntry(index, hamtEntry{key: key, value: value})
+				return updated, false
+			}
+		}
+		return &hamtNode{entries: append(append([]hamtEntry{}, node.entries...), hamtEntry{key: key, value: value})}, true
+	}
+	bit := uint32(1) << ((hash >> shift) & branchMask)
+	index := node.position(bit)
+	if node.bitmap&bit == 0 {
+		entries := make([]hamtEntry, len(node.entries)+1)
+		copy(entries, node.entries[:index])
+		entries[index] = hamtEntry{key: key, value: value}
+		copy(entries[index+1:], node.entries[index:])
+		return &hamtNode{node.bitmap | bit, entries}, true
+	}
+	entry := node.entries[index]
+	if entry.child != nil {
+		child, isAdded := entry.child.set(shift+branchBits, hash, key, value)
+		return node.withEntry(index, hamtEntry{child: child}), isAdded
+	}
+	if entry.key == key {
+		return node.withEntry(index, hamtEntry{key: key, value: value}), false
+	}
+	// Two different keys share the slot, so the slot becomes a child node
+	// holding both of them.
+	child, _ := (*hamtNode)(nil).set(shift+branchBits, hashOf(entry.key), entry.key, entry.value)
+	child, _ = child.set(shift+branchBits, hash, key, value)
+	return node.withEntry(index, hamtEntry{child: child}), true
+}
+
+// The delete() function returns a copy of the node without the given key,
+// and whether the key was deleted. It returns a nil node if the node
+// becomes empty.
+func (node *hamtNode) delete(shift uint, hash uint32, key string) (*hamtNode, bool) {
+	if shift > maxShift {
+		for index, entry := range node.entries {
+			if entry.key == key {
+				if len(node.entries) == 1 {
+					return nil, true
+				}
+				entries := append(append([]hamtEntry{}, node.entries[:index]...), node.entries[index+1:]...)
+				return &hamtNode{entries: entries}, true
+			}
+		}
+		return node, false
+	}
+	bit := uint32(1) << ((hash >> shift) & branchMask)
+	if node.bitmap&bit == 0 {
+		return node, false
+	}
+	index := node.position(bit)
+	entry := node.entries[index]
+	if entry.child == nil {
+		if entry.key != key {
+			return node, false
+		}
+		return node.withoutEntry(index, bit), true
+	}
+	child, isDeleted := entry.child.delete(shift+branchBits, hash, key)
+	if !isDeleted {
+		return node, false
+	}
+	if child == nil {
+		return node.withoutEntry(index, bit), true
+	}
+	// A child that only holds a single key-value pair is moved up into the
+	// node, so the trie does not keep a chain of single-entry nodes.
+	if len(child.entries) == 1 && child.entries[0].child == nil {
+		return node.withEntry(index, child.entries[0]), true
+	}
+	return node.withEntry(index, hamtEntry{child: child}), true
+}
+
+func (node *hamtNode) withEntry(index int, entry hamtEntry) *hamtNode {
+	entries := make([]hamtEntry, len(node.entries))
+	copy(entries, node.entries)
+	entries[index] = entry
+	return &hamtNode{node.bitmap, entries}
+}
+
+func (node *hamtNode) withoutEntry(index int, bit uint32) *hamtNode {
+	if len(node.entries) == 1 {
+		return nil
+	}
+	entries := make([]hamtEntry, 0, len(node.entries)-1)
+	entries = append(entries, node.entries[:index]...)
+	entries = append(entries, node.entries[index+1:]...)
+	return &hamtNode{node.bitmap &^ bit, entries}
+}
+
+// The walk() function calls the given function for each key-value pair of
+// the node and its children, in no particular order.
+func (node *hamtNode) walk(function func(entry *hamtEntry)) {
+	if node == nil {
+		return
+	}
+	for index := range node.entries {
+		if entry := &node.entries[index]; entry.child != nil {
+			entry.child.walk(function)
+		} else {
+			function(entry)
+		}
+	}
+}
+
+type ObjectForEachFunc func (key string, value interface{})
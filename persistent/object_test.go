@@ -0,0 +1,97 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package persistent
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/with-go/standard/object"
+)
+
+func TestObjectOf(t *testing.T) {
+	converted := ObjectOf(object.New().Set("b", 2).Set("a", 1))
+	if converted.String() != "{\"a\":1,\"b\":2}" || !reflect.DeepEqual(converted.Values(), []interface{}{1, 2}) {
+		t.Error("ObjectOf() value does not match")
+		t.Errorf("Expecting %v, got %v", "{\"a\":1,\"b\":2}", converted)
+		return
+	}
+}
+
+func TestObject_Delete(t *testing.T) {
+	var original Object
+	for index := 0; index < testLength; index++ {
+		original = original.Set(strconv.Itoa(index), index)
+	}
+	deleted := original
+	for index := 0; index < testLength; index += 2 {
+		deleted = deleted.Delete(strconv.Itoa(index))
+	}
+	if deleted.Length() != testLength/2 || original.Length() != testLength {
+		t.Error("object.Delete() length does not match")
+		t.Errorf("Expecting %v, got %v", testLength/2, deleted.Length())
+		return
+	}
+	for index := 0; index < testLength; index++ {
+		key := strconv.Itoa(index)
+		if deleted.Has(key) != (index%2 == 1) || original.Get(key) != index {
+			t.Error("object.Delete() value does not match")
+			t.Errorf("Expecting %v, got %v", index%2 == 1, deleted.Has(key))
+			return
+		}
+	}
+	if same := deleted.Delete("missing"); same != deleted {
+		t.Error("object.Delete() does not return the same Object for a missing key")
+		t.Errorf("Expecting %v, got %v", deleted.Length(), same.Length())
+		return
+	}
+	empty := deleted
+	for _, key := range deleted.Keys() {
+		empty = empty.Delete(key)
+	}
+	if empty.Length() != 0 || empty.root != nil {
+		t.Error("object.Delete() does not empty the Object")
+		t.Errorf("Expecting %v, got %v", 0, empty.Length())
+		return
+	}
+}
+
+func TestObject_Set(t *testing.T) {
+	var original Object
+	for index := 0; index < testLength; index++ {
+		original = original.Set(strconv.Itoa(index), index)
+	}
+	updated := original.Set("7", "seven").Set("new", true)
+	if original.Get("7") != 7 || original.Has("new") || updated.Get("7") != "seven" || updated.Length() != testLength+1 {
+		t.Error("object.Set() changes another version")
+		t.Errorf("Expecting %v, got %v", 7, original.Get("7"))
+		return
+	}
+	if value, exists := updated.Lookup("missing"); exists || value != nil {
+		t.Error("object.Lookup() value does not match")
+		t.Errorf("Expecting %v, got %v", nil, value)
+		return
+	}
+}
+
+func TestObject_Set_collision(t *testing.T) {
+	// Keys with the same hash are kept in a collision node.
+	node, _ := (*hamtNode)(nil).set(maxShift+branchBits, 0, "a", 1)
+	node, _ = node.set(maxShift+branchBits, 0, "b", 2)
+	node, _ = node.set(maxShift+branchBits, 0, "a", 10)
+	if len(node.entries) != 2 || node.entries[0].value != 10 {
+		t.Error("hamtNode.set() collision value does not match")
+		t.Errorf("Expecting %v, got %v", 2, len(node.entries))
+		return
+	}
+	node, _ = node.delete(maxShift+branchBits, 0, "a")
+	if node, _ = node.delete(maxShift+branchBits, 0, "b"); node != nil {
+		t.Error("hamtNode.delete() does not empty the collision node")
+		t.Errorf("Expecting %v, got %v", nil, node)
+		return
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+/*
+The Pretty prints nested values, such as Arrays, Objects and Collections,
+as indented multi-line text for debugging. A value such as
+
+	collection.New().Set("id", 1).Set("tags", array.New("a", "b"))
+
+is printed as
+
+	{
+	  "id": 1,
+	  "tags": [
+	    "a",
+	    "b"
+	  ]
+	}
+
+The printed text looks like JSON, but it is not meant to be parsed, as the
+values that JSON cannot represent are printed using the %v verb of the
+"fmt" package, and the output may be shortened by the Options. Slices and
+arrays are printed as lists, maps are printed with their keys sorted, and a
+value that implements the OrderedMap of "cycle" package, such as a
+Collection, is printed with its keys in order.
+
+The Array, Object and Collection implement fmt.Formatter using this package,
+so they can be printed using the %+v verb, and the %#v verb prints the Go
+source that rebuilds them.
+*/
+package pretty
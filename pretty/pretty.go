@@ -0,0 +1,365 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package pretty
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/with-go/standard/cycle"
+)
+
+// ANSI escape codes used when Options.Colors is enabled.
+const (
+	colorReset   = "\x1b[0m"
+	colorKey     = "\x1b[36m"
+	colorString  = "\x1b[32m"
+	colorNumber  = "\x1b[33m"
+	colorKeyword = "\x1b[35m"
+	colorMarker  = "\x1b[90m"
+)
+
+// Options defines the options used to print a value. Use DefaultOptions as
+// the starting point of custom options.
+type Options struct {
+	// Indent is the string used to indent each nested level.
+	Indent string
+	// MaxDepth is the maximum number of nested containers to print. A
+	// deeper container is printed as [...] or {...}. Set it to zero to print
+	// every level.
+	MaxDepth int
+	// MaxItems is the maximum number of items to print for each container.
+	// The rest of the items are replaced by a single "... (n more)" line.
+	// Set it to zero to print every item.
+	MaxItems int
+	// MaxStringLength is the maximum number of characters to print for each
+	// string. A longer string is cut and ends with "...". Set it to zero to
+	// print every string in full.
+	MaxStringLength int
+	// Colors highlights the keys, strings, numbers and keywords using ANSI
+	// escape codes.
+	Colors bool
+}
+
+// DefaultOptions defines the options used by the Sprint() function, and by
+// the %+v verb of an Array, an Object or a Collection.
+var DefaultOptions = Options{
+	Indent: "  ",
+}
+
+// The Sprint() function prints the value using DefaultOptions, and returns
+// the result as a string.
+func Sprint(v interface{}) string {
+	return SprintWithOptions(v, DefaultOptions)
+}
+
+// The SprintWithOptions() function is the same as Sprint() function, but
+// uses the given options.
+func SprintWithOptions(v interface{}, options Options) string {
+	printer := &printer{options: options}
+	printer.print(v, 0)
+	return printer.String()
+}
+
+// The Compact() function prints the value on a single line, without
+// indentation, in the same format as Sprint() function. It is used by the
+// String() function of an Array and an Object when the value cannot be
+// encoded as JSON, such as a circular value.
+func Compact(v interface{}) string {
+	printer := &printer{isCompact: true}
+	printer.print(v, 0)
+	return printer.String()
+}
+
+// The Fprint() function is the same as SprintWithOptions() function, but
+// writes the result to the given writer.
+func Fprint(w io.Writer, v interface{}, options Options) error {
+	_, err := io.WriteString(w, SprintWithOptions(v, options))
+	return err
+}
+
+// The GoString() function returns the Go source that rebuilds the value,
+// which is used by the %#v verb. A number whose type is not the default
+// type of its constant, such as an int64, is converted explicitly, so it
+// keeps its type when it is stored in an interface{}. Slices, arrays and
+// maps are written as composite literals, and a cycle.OrderedMap is
+// written as a call to the New() function of its package, followed by a
+// Set() call for each key. Any other value that implements fmt.GoStringer is written using
+// its GoString() function. A circular value cannot be written as a
+// literal, so it is written as nil followed by a comment holding the
+// Marker of "cycle" package.
+func GoString(v interface{}) string {
+	return goString(v, &cycle.Tracker{})
+}
+
+func goString(v interface{}, tracker *cycle.Tracker) string {
+	if v == nil {
+		return "nil"
+	}
+	reflection := reflect.ValueOf(v)
+	valueType := reflection.Type()
+	if !tracker.Enter(v) {
+		return "nil /* " + cycle.Marker + " */"
+	}
+	defer tracker.Leave(v)
+	if ordered, ok := v.(cycle.OrderedMap); ok && !(reflection.Kind() == reflect.Ptr && reflection.IsNil()) {
+		name := strings.TrimPrefix(valueType.String(), "*")
+		var builder strings.Builder
+		builder.WriteString(name[:strings.LastIndex(name, ".")+1] + "New()")
+		for _, key := range ordered.Keys() {
+			builder.WriteString(fmt.Sprintf(".Set(%s, %s)", goString(key, tracker), goString(ordered.Get(key), tracker)))
+		}
+		return builder.String()
+	}
+	switch reflection.Kind() {
+	case reflect.Slice, reflect.Array:
+		if reflection.Kind() == reflect.Slice && reflection.IsNil() {
+			return fmt.Sprintf("%s(nil)", valueType)
+		}
+		elements := make([]string, reflection.Len())
+		for index := range elements {
+			elements[index] = goString(reflection.Index(index).Interface(), tracker)
+		}
+		return fmt.Sprintf("%s{%s}", valueType, strings.Join(elements, ", "))
+	case reflect.Map:
+		if reflection.IsNil() {
+			return fmt.Sprintf("%s(nil)", valueType)
+		}
+		keys := make([]string, 0, reflection.Len())
+		values := make(map[string]string, reflection.Len())
+		iterator := reflection.MapRange()
+		for iterator.Next() {
+			key := goString(iterator.Key().Interface(), tracker)
+			keys = append(keys, key)
+			values[key] = goString(iterator.Value().Interface(), tracker)
+		}
+		sort.Strings(keys)
+		elements := make([]string, len(keys))
+		for index, key := range keys {
+			elements[index] = key + ": " + values[key]
+		}
+		return fmt.Sprintf("%s{%s}", valueType, strings.Join(elements, ", "))
+	}
+	if stringer, ok := v.(fmt.GoStringer); ok {
+		return stringer.GoString()
+	}
+	switch reflection.Kind() {
+	case reflect.Bool:
+		return convert(valueType, reflect.Bool, strconv.FormatBool(reflection.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return convert(valueType, reflect.Int, strconv.FormatInt(reflection.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return convert(valueType, reflect.Invalid, strconv.FormatUint(reflection.Uint(), 10))
+	case reflect.Float32, reflect.Float64:
+		return convert(valueType, reflect.Float64, floatString(reflection.Float(), valueType.Bits()))
+	case reflect.String:
+		return convert(valueType, reflect.String, strconv.Quote(reflection.String()))
+	}
+	return fmt.Sprintf("%#v", v)
+}
+
+// The Format() function implements fmt.Formatter for the given value. The
+// %+v verb prints the value using Sprint() function, the %#v verb prints
+// it using GoString() function, and the other verbs that accept a string,
+// including %v, format the result of its String() function. The rest of
+// the verbs format the given raw value instead.
+func Format(state fmt.State, verb rune, value fmt.Stringer, raw interface{}) {
+	switch {
+	case verb == 'v' && state.Flag('#'):
+		_, _ = io.WriteString(state, GoString(value))
+	case verb == 'v' && state.Flag('+'):
+		_, _ = io.WriteString(state, Sprint(value))
+	case verb == 'v' || verb == 's' || verb == 'q' || verb == 'x' || verb == 'X':
+		_, _ = fmt.Fprintf(state, formatOf(state, verb), value.String())
+	default:
+		_, _ = fmt.Fprintf(state, formatOf(state, verb), raw)
+	}
+}
+
+// The formatOf() function rebuilds the format string of the given state and
+// verb.
+func formatOf(state fmt.State, verb rune) string {
+	format := "%"
+	for _, flag := range "+-# 0" {
+		if state.Flag(int(flag)) {
+			format += string(flag)
+		}
+	}
+	if width, ok := state.Width(); ok {
+		format += strconv.Itoa(width)
+	}
+	if precision, ok := state.Precision(); ok {
+		format += "." + strconv.Itoa(precision)
+	}
+	return format + string(verb)
+}
+
+// The convert() function wraps the literal in a conversion to the given
+// type, unless the type is the default type of the literal.
+func convert(valueType reflect.Type, defaultKind reflect.Kind, literal string) string {
+	if valueType.PkgPath() == "" && valueType.Kind() == defaultKind {
+		return literal
+	}
+	return fmt.Sprintf("%s(%s)", valueType, literal)
+}
+
+func floatString(value float64, bitSize int) string {
+	switch {
+	case math.IsNaN(value):
+		return "math.NaN()"
+	case math.IsInf(value, 1):
+		return "math.Inf(1)"
+	case math.IsInf(value, -1):
+		return "math.Inf(-1)"
+	}
+	literal := strconv.FormatFloat(value, 'g', -1, bitSize)
+	if !strings.ContainsAny(literal, ".e") {
+		literal += ".0"
+	}
+	return literal
+}
+
+type printer struct {
+	strings.Builder
+	options   Options
+	isCompact bool
+	tracker   cycle.Tracker
+}
+
+func (printer *printer) print(v interface{}, depth int) {
+	if v == nil {
+		printer.colored(colorKeyword, "null")
+		return
+	}
+	reflection := reflect.ValueOf(v)
+	switch reflection.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface:
+		if reflection.IsNil() {
+			printer.colored(colorKeyword, "null")
+			return
+		}
+	}
+	if !printer.tracker.Enter(v) {
+		printer.colored(colorMarker, cycle.Marker)
+		return
+	}
+	defer printer.tracker.Leave(v)
+	if ordered, ok := v.(cycle.OrderedMap); ok {
+		keys := ordered.Keys()
+		printer.container("{", "}", len(keys), depth, func(index int) {
+			printer.key(keys[index])
+			printer.print(ordered.Get(keys[index]), depth+1)
+		})
+		return
+	}
+	if number, ok := v.(json.Number); ok {
+		printer.colored(colorNumber, number.String())
+		return
+	}
+	switch reflection.Kind() {
+	case reflect.Bool:
+		printer.colored(colorKeyword, strconv.FormatBool(reflection.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		printer.colored(colorNumber, fmt.Sprintf("%v", v))
+	case reflect.String:
+		printer.colored(colorString, printer.quote(reflection.String()))
+	case reflect.Slice, reflect.Array:
+		printer.container("[", "]", reflection.Len(), depth, func(index int) {
+			printer.print(reflection.Index(index).Interface(), depth+1)
+		})
+	case reflect.Map:
+		keys := reflection.MapKeys()
+		sort.Slice(keys, func(i int, j int) bool {
+			return fmt.Sprintf("%v", keys[i].Interface()) < fmt.Sprintf("%v", keys[j].Interface())
+		})
+		printer.container("{", "}", len(keys), depth, func(index int) {
+			printer.key(keys[index].Interface())
+			printer.print(reflection.MapIndex(keys[index]).Interface(), depth+1)
+		})
+	default:
+		printer.WriteString(fmt.Sprintf("%v", v))
+	}
+}
+
+// The container() function prints a list or a map of the given length,
+// calling the given function to print each item.
+func (printer *printer) container(open string, close string, length int, depth int, function func(index int)) {
+	if length == 0 {
+		printer.WriteString(open + close)
+		return
+	}
+	if printer.options.MaxDepth > 0 && depth >= printer.options.MaxDepth {
+		printer.colored(colorMarker, open+"..."+close)
+		return
+	}
+	shown := length
+	if printer.options.MaxItems > 0 && shown > printer.options.MaxItems {
+		shown = printer.options.MaxItems
+	}
+	printer.WriteString(open)
+	for index := 0; index < shown; index++ {
+		if index > 0 {
+			printer.WriteString(",")
+		}
+		printer.newline(depth + 1)
+		function(index)
+	}
+	if shown < length {
+		printer.WriteString(",")
+		printer.newline(depth + 1)
+		printer.colored(colorMarker, fmt.Sprintf("... (%d more)", length-shown))
+	}
+	printer.newline(depth)
+	printer.WriteString(close)
+}
+
+func (printer *printer) key(key interface{}) {
+	text, ok := key.(string)
+	if !ok {
+		text = fmt.Sprintf("%v", key)
+	}
+	printer.colored(colorKey, strconv.Quote(text))
+	if printer.isCompact {
+		printer.WriteString(":")
+		return
+	}
+	printer.WriteString(": ")
+}
+
+func (printer *printer) newline(depth int) {
+	if printer.isCompact {
+		return
+	}
+	printer.WriteString("\n")
+	printer.WriteString(strings.Repeat(printer.options.Indent, depth))
+}
+
+// The quote() function quotes the string, cutting it to MaxStringLength
+// characters first.
+func (printer *printer) quote(text string) string {
+	limit := printer.options.MaxStringLength
+	if limit > 0 && utf8.RuneCountInString(text) > limit {
+		return strconv.Quote(string([]rune(text)[:limit]) + "...")
+	}
+	return strconv.Quote(text)
+}
+
+func (printer *printer) colored(color string, text string) {
+	if printer.options.Colors {
+		printer.WriteString(color + text + colorReset)
+		return
+	}
+	printer.WriteString(text)
+}
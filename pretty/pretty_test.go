@@ -0,0 +1,184 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package pretty
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"testing"
+)
+
+type orderedMap struct {
+	keys   []interface{}
+	values []interface{}
+}
+
+func (ordered orderedMap) Keys() []interface{} {
+	return ordered.keys
+}
+
+func (ordered orderedMap) Get(key interface{}) interface{} {
+	for index, candidate := range ordered.keys {
+		if candidate == key {
+			return ordered.values[index]
+		}
+	}
+	return nil
+}
+
+func TestFprint(t *testing.T) {
+	var buffer bytes.Buffer
+	if err := Fprint(&buffer, []interface{}{1}, Options{}); err != nil || buffer.String() != "[\n1\n]" {
+		t.Error("Fprint() value does not match")
+		t.Errorf("Expecting %q, got %q", "[\n1\n]", buffer.String())
+		return
+	}
+}
+
+func TestGoString(t *testing.T) {
+	tests := []struct {
+		value    interface{}
+		expected string
+	}{
+		{nil, "nil"},
+		{1, "1"},
+		{int64(1), "int64(1)"},
+		{uint(1), "uint(1)"},
+		{1.0, "1.0"},
+		{1.5e21, "1.5e+21"},
+		{float32(0.5), "float32(0.5)"},
+		{math.NaN(), "math.NaN()"},
+		{"a\"b", "\"a\\\"b\""},
+		{true, "true"},
+		{json.Number("1.5"), "json.Number(\"1.5\")"},
+		{[]interface{}{1, "a"}, "[]interface {}{1, \"a\"}"},
+		{[]int(nil), "[]int(nil)"},
+		{map[string]int{"b": 2, "a": 1}, "map[string]int{\"a\": 1, \"b\": 2}"},
+	}
+	for _, test := range tests {
+		if result := GoString(test.value); result != test.expected {
+			t.Error("GoString() value does not match")
+			t.Errorf("Expecting %v, got %v", test.expected, result)
+			return
+		}
+	}
+}
+
+func TestSprint(t *testing.T) {
+	value := orderedMap{
+		keys:   []interface{}{"b", 1},
+		values: []interface{}{[]interface{}{1, "x", nil}, map[string]interface{}{"z": true, "a": json.Number("2")}},
+	}
+	expected := "{\n" +
+		"  \"b\": [\n" +
+		"    1,\n" +
+		"    \"x\",\n" +
+		"    null\n" +
+		"  ],\n" +
+		"  \"1\": {\n" +
+		"    \"a\": 2,\n" +
+		"    \"z\": true\n" +
+		"  }\n" +
+		"}"
+	if result := Sprint(value); result != expected {
+		t.Error("Sprint() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, result)
+		return
+	}
+	if result := Sprint([]interface{}{}); result != "[]" {
+		t.Error("Sprint() empty value does not match")
+		t.Errorf("Expecting %v, got %v", "[]", result)
+		return
+	}
+}
+
+func TestSprintWithOptions(t *testing.T) {
+	value := map[string]interface{}{
+		"list":   []int{1, 2, 3, 4, 5},
+		"nested": map[string]interface{}{"deep": []int{1}},
+		"text":   "abcdefgh",
+	}
+	options := Options{Indent: " ", MaxDepth: 2, MaxItems: 3, MaxStringLength: 3}
+	expected := "{\n" +
+		" \"list\": [\n" +
+		"  1,\n" +
+		"  2,\n" +
+		"  3,\n" +
+		"  ... (2 more)\n" +
+		" ],\n" +
+		" \"nested\": {\n" +
+		"  \"deep\": [...]\n" +
+		" },\n" +
+		" \"text\": \"abc...\"\n" +
+		"}"
+	if result := SprintWithOptions(value, options); result != expected {
+		t.Error("SprintWithOptions() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, result)
+		return
+	}
+	colored := SprintWithOptions([]interface{}{"a"}, Options{Colors: true})
+	if expected := "[\n" + colorString + "\"a\"" + colorReset + "\n]"; colored != expected {
+		t.Error("SprintWithOptions() colored value does not match")
+		t.Errorf("Expecting %q, got %q", expected, colored)
+		return
+	}
+}
+
+func TestSprint_circular(t *testing.T) {
+	circular := map[string]interface{}{"a": 1}
+	circular["b"] = []interface{}{circular}
+	expected := "{\n  \"a\": 1,\n  \"b\": [\n    [Circular]\n  ]\n}"
+	if result := Sprint(circular); result != expected {
+		t.Error("Sprint() circular value does not match")
+		t.Errorf("Expecting %v, got %v", expected, result)
+		return
+	}
+	if result := Compact(circular); result != "{\"a\":1,\"b\":[[Circular]]}" {
+		t.Error("Compact() circular value does not match")
+		t.Errorf("Expecting %v, got %v", "{\"a\":1,\"b\":[[Circular]]}", result)
+		return
+	}
+	expected = "map[string]interface {}{\"a\": 1, \"b\": []interface {}{nil /* [Circular] */}}"
+	if result := GoString(circular); result != expected {
+		t.Error("GoString() circular value does not match")
+		t.Errorf("Expecting %v, got %v", expected, result)
+		return
+	}
+}
+
+type formatted []interface{}
+
+func (value formatted) Format(state fmt.State, verb rune) {
+	Format(state, verb, value, []interface{}(value))
+}
+
+func (value formatted) String() string {
+	return "compact"
+}
+
+func TestFormat(t *testing.T) {
+	value := formatted{1, 2}
+	tests := []struct {
+		format   string
+		expected string
+	}{
+		{"%v", "compact"},
+		{"%10s", "   compact"},
+		{"%q", "\"compact\""},
+		{"%+v", "[\n  1,\n  2\n]"},
+		{"%#v", "pretty.formatted{1, 2}"},
+		{"%03d", "[001 002]"},
+	}
+	for _, test := range tests {
+		if result := fmt.Sprintf(test.format, value); result != test.expected {
+			t.Error("Format() value does not match")
+			t.Errorf("Expecting %q, got %q", test.expected, result)
+			return
+		}
+	}
+}
@@ -0,0 +1,17 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+/*
+The Schema infers a JSON Schema document from one or more sample documents.
+Samples may be an Object, a Collection, an Array or any native map, slice
+and scalar value. Every sample is merged into the same description, so the
+more samples are given, the more accurate the inferred schema will be.
+
+The inferred schema is returned as a Collection, which means the property
+order of Collection samples is kept in the generated schema. Properties of
+an Object or a native map sample are sorted alphabetically, as those types
+do not remember the insertion order.
+*/
+package schema
@@ -0,0 +1,365 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package schema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+
+	"github.com/with-go/standard/array"
+	"github.com/with-go/standard/collection"
+	"github.com/with-go/standard/cycle"
+	"github.com/with-go/standard/object"
+)
+
+// Draft is the JSON Schema dialect used by the inferred schema.
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+var (
+	UnsupportedTypeError = errors.New("the given sample contains an unsupported type, " +
+		"sample should only contain maps, slices, strings, numbers, booleans or nil")
+)
+
+// The order of JSON types in the "type" keyword of the inferred schema.
+var typeOrder = []string{"null", "boolean", "integer", "number", "string", "array", "object"}
+
+// Options defines the options used by an Inferrer to infer a schema.
+type Options struct {
+	// EnumLimit is the maximum number of distinct values a string field may
+	// have to be reported as an enum. A field is only reported as an enum if
+	// at least one of its values is repeated across the samples. Set it to
+	// zero to disable enum inference.
+	EnumLimit int
+}
+
+// DefaultOptions defines the options used by the New() function.
+var DefaultOptions = Options{
+	EnumLimit: 10,
+}
+
+// The Infer() function infers a JSON Schema from the given samples using
+// the DefaultOptions. It is a shorthand of New().Add(samples...) followed
+// by Schema().
+func Infer(samples ...interface{}) (*collection.Collection, error) {
+	inferrer := New()
+	if err := inferrer.Add(samples...); err != nil {
+		return nil, err
+	}
+	return inferrer.Schema(), nil
+}
+
+// The New() function creates a new Inferrer using the DefaultOptions.
+func New() *Inferrer {
+	return NewWithOptions(DefaultOptions)
+}
+
+// The NewWithOptions() function creates a new Inferrer using the given
+// options.
+func NewWithOptions(options Options) *Inferrer {
+	return &Inferrer{options, &node{}}
+}
+
+// Inferrer defines an Inferrer Type, which collects samples and describes
+// them as a JSON Schema. See "schema" package documentation for more
+// information.
+type Inferrer struct {
+	options Options
+	root    *node
+}
+
+// The Add() function merges one or more samples into the Inferrer. If a
+// sample contains an unsupported type, such as a function or a channel,
+// it will returns UnsupportedTypeError and the sample will not be merged. If
+// a sample holds a circular reference, it will returns a cycle.PathError.
+func (inferrer *Inferrer) Add(samples ...interface{}) error {
+	for _, sample := range samples {
+		if err := cycle.Check(sample); err != nil {
+			return err
+		}
+		value, err := normalize(sample)
+		if err != nil {
+			return err
+		}
+		inferrer.root.observe(value, inferrer.options)
+	}
+	return nil
+}
+
+// The Count() function returns the number of samples merged into the
+// Inferrer.
+func (inferrer *Inferrer) Count() int {
+	return inferrer.root.count
+}
+
+// The Schema() function returns the JSON Schema describing every sample
+// merged into the Inferrer so far. The schema is returned as a Collection,
+// so its String() function returns the schema as a JSON string.
+//
+// Each field reports the JSON types seen, and object fields report their
+// properties in order of first appearance, along with the list of
+// properties present in every sample. String fields with few distinct
+// values report them as an enum, and numeric fields report the minimum
+// and maximum values seen.
+func (inferrer *Inferrer) Schema() *collection.Collection {
+	schema := collection.New().Set("$schema", Draft)
+	inferrer.root.schema(inferrer.options).ForEach(func(key interface{}, value interface{}) {
+		schema.Set(key, value)
+	})
+	return schema
+}
+
+// document is the normalized form of an Object, a Collection or a map.
+type document struct {
+	keys   []string
+	values []interface{}
+}
+
+// number is the normalized form of any numeric value.
+type number struct {
+	value   float64
+	integer bool
+}
+
+// node describes every value observed at the same location in the samples.
+type node struct {
+	count      int
+	types      map[string]bool
+	objects    int
+	properties *collection.Collection
+	items      *node
+	strings    int
+	enum       []string
+	overflow   bool
+	numbers    int
+	minimum    float64
+	maximum    float64
+}
+
+func (field *node) observe(value interface{}, options Options) {
+	field.count++
+	if field.types == nil {
+		field.types = make(map[string]bool)
+	}
+	switch value := value.(type) {
+	case nil:
+		field.types["null"] = true
+	case bool:
+		field.types["boolean"] = true
+	case string:
+		field.types["string"] = true
+		field.strings++
+		field.observeEnum(value, options)
+	case number:
+		if value.integer {
+			field.types["integer"] = true
+		} else {
+			field.types["number"] = true
+		}
+		if field.numbers == 0 || value.value < field.minimum {
+			field.minimum = value.value
+		}
+		if field.numbers == 0 || value.value > field.maximum {
+			field.maximum = value.value
+		}
+		field.numbers++
+	case []interface{}:
+		field.types["array"] = true
+		if field.items == nil {
+			field.items = &node{}
+		}
+		for _, element := range value {
+			field.items.observe(element, options)
+		}
+	case *document:
+		field.types["object"] = true
+		field.objects++
+		if field.properties == nil {
+			field.properties = collection.New()
+		}
+		for index, key := range value.keys {
+			child, _ := field.properties.Get(key).(*node)
+			if child == nil {
+				child = &node{}
+				field.properties.Set(key, child)
+			}
+			child.observe(value.values[index], options)
+		}
+	}
+}
+
+func (field *node) observeEnum(value string, options Options) {
+	if field.overflow {
+		return
+	}
+	for _, candidate := range field.enum {
+		if candidate == value {
+			return
+		}
+	}
+	if len(field.enum) >= options.EnumLimit {
+		field.enum = nil
+		field.overflow = true
+		return
+	}
+	field.enum = append(field.enum, value)
+}
+
+func (field *node) schema(options Options) *collection.Collection {
+	schema := collection.New()
+	var types []interface{}
+	for _, name := range typeOrder {
+		if name == "integer" && field.types["number"] {
+			continue
+		}
+		if field.types[name] {
+			types = append(types, name)
+		}
+	}
+	switch len(types) {
+	case 0:
+		break
+	case 1:
+		schema.Set("type", types[0])
+	default:
+		schema.Set("type", array.New(types...))
+	}
+	if len(types) == 1 && types[0] == "string" && !field.overflow && len(field.enum) < field.strings {
+		enum := array.New()
+		for _, value := range field.enum {
+			enum.PushInPlace(value)
+		}
+		schema.Set("enum", enum)
+	}
+	if field.numbers > 0 {
+		if field.types["number"] {
+			schema.Set("minimum", field.minimum)
+			schema.Set("maximum", field.maximum)
+		} else {
+			schema.Set("minimum", int64(field.minimum))
+			schema.Set("maximum", int64(field.maximum))
+		}
+	}
+	if field.items != nil && field.items.count > 0 {
+		schema.Set("items", field.items.schema(options))
+	}
+	if field.properties != nil {
+		properties := collection.New()
+		required := array.New()
+		field.properties.ForEach(func(key interface{}, value interface{}) {
+			child := value.(*node)
+			properties.Set(key, child.schema(options))
+			if child.count == field.objects {
+				required.PushInPlace(key)
+			}
+		})
+		schema.Set("properties", properties)
+		if required.Length() > 0 {
+			schema.Set("required", required)
+		}
+	}
+	return schema
+}
+
+// The normalize() function converts a sample into a tree of *document,
+// []interface{}, number, string, bool and nil values.
+func normalize(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case *collection.Collection:
+		if v == nil {
+			return nil, nil
+		}
+		keys := make([]string, 0, v.Length())
+		for _, key := range v.Keys() {
+			keys = append(keys, collection.KeyString(key))
+		}
+		return normalizeDocument(keys, v.Values())
+	case object.Object:
+		return normalizeDocument(v.Keys(), v.Values())
+	case array.Array:
+		return normalizeSlice(reflect.ValueOf(v))
+	case json.Number:
+		if integer, err := v.Int64(); err == nil {
+			return number{float64(integer), true}, nil
+		}
+		float, err := v.Float64()
+		if err != nil {
+			return nil, err
+		}
+		return normalizeFloat(float), nil
+	case string, bool:
+		return v, nil
+	}
+	reflection := reflect.ValueOf(v)
+	switch reflection.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if reflection.IsNil() {
+			return nil, nil
+		}
+		return normalize(reflection.Elem().Interface())
+	case reflect.Map:
+		keys := make([]string, 0, reflection.Len())
+		members := make(map[string]interface{})
+		for _, key := range reflection.MapKeys() {
+			name := fmt.Sprint(key.Interface())
+			keys = append(keys, name)
+			members[name] = reflection.MapIndex(key).Interface()
+		}
+		sort.Strings(keys)
+		values := make([]interface{}, len(keys))
+		for index, key := range keys {
+			values[index] = members[key]
+		}
+		return normalizeDocument(keys, values)
+	case reflect.Slice, reflect.Array:
+		return normalizeSlice(reflection)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return number{float64(reflection.Int()), true}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return number{float64(reflection.Uint()), true}, nil
+	case reflect.Float32, reflect.Float64:
+		return normalizeFloat(reflection.Float()), nil
+	case reflect.String:
+		return reflection.String(), nil
+	case reflect.Bool:
+		return reflection.Bool(), nil
+	}
+	return nil, UnsupportedTypeError
+}
+
+func normalizeDocument(keys []string, values []interface{}) (interface{}, error) {
+	document := &document{keys, make([]interface{}, len(keys))}
+	for index := range keys {
+		value, err := normalize(values[index])
+		if err != nil {
+			return nil, err
+		}
+		document.values[index] = value
+	}
+	return document, nil
+}
+
+func normalizeSlice(reflection reflect.Value) (interface{}, error) {
+	slice := make([]interface{}, reflection.Len())
+	for index := range slice {
+		value, err := normalize(reflection.Index(index).Interface())
+		if err != nil {
+			return nil, err
+		}
+		slice[index] = value
+	}
+	return slice, nil
+}
+
+func normalizeFloat(value float64) number {
+	integer := !math.IsInf(value, 0) && !math.IsNaN(value) && math.Trunc(value) == value
+	return number{value, integer}
+}
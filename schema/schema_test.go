@@ -0,0 +1,104 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/with-go/standard/array"
+	"github.com/with-go/standard/collection"
+	"github.com/with-go/standard/cycle"
+	"github.com/with-go/standard/object"
+)
+
+func TestInfer(t *testing.T) {
+	first := collection.New().
+		Set("name", "alpha").
+		Set("status", "open").
+		Set("score", 10).
+		Set("tags", array.New("a", "b"))
+	second := collection.New().
+		Set("name", "beta").
+		Set("status", "open").
+		Set("score", 2.5).
+		Set("owner", nil)
+	schema, err := Infer(first, second)
+	if err != nil {
+		t.Error("Infer(samples) failed to infer schema")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	expecting := "{\"$schema\":\"" + Draft + "\",\"type\":\"object\",\"properties\":{" +
+		"\"name\":{\"type\":\"string\"}," +
+		"\"status\":{\"type\":\"string\",\"enum\":[\"open\"]}," +
+		"\"score\":{\"type\":\"number\",\"minimum\":2.5,\"maximum\":10}," +
+		"\"tags\":{\"type\":\"array\",\"items\":{\"type\":\"string\"}}," +
+		"\"owner\":{\"type\":\"null\"}}," +
+		"\"required\":[\"name\",\"status\",\"score\"]}"
+	if fmt.Sprint(schema) != expecting {
+		t.Error("Infer(samples) schema does not match")
+		t.Errorf("Expecting %v, got %v", expecting, fmt.Sprint(schema))
+		return
+	}
+}
+
+func TestInfer_circular(t *testing.T) {
+	circular := object.New()
+	circular.Set("items", array.New(1, circular))
+	_, err := Infer(circular)
+	var pathError *cycle.PathError
+	if !errors.As(err, &pathError) || !reflect.DeepEqual(pathError.Path, []interface{}{"items", 1}) {
+		t.Error("Infer() error does not match")
+		t.Errorf("Expecting %v, got %v", "$.items[1]", err)
+		return
+	}
+}
+
+func TestInferrer_Add(t *testing.T) {
+	inferrer := New()
+	err := inferrer.Add(object.New().Set("callback", func() {}))
+	if err != UnsupportedTypeError {
+		t.Error("inferrer.Add(samples) accepted an unsupported type")
+		t.Errorf("Expecting %v, got %v", UnsupportedTypeError, err)
+		return
+	}
+	if inferrer.Count() != 0 {
+		t.Error("inferrer.Add(samples) merged a rejected sample")
+		t.Errorf("Expecting %v, got %v", 0, inferrer.Count())
+		return
+	}
+	err = inferrer.Add(map[string]interface{}{"id": 3, "active": true},
+		map[string]interface{}{"id": 7, "active": nil})
+	if err != nil {
+		t.Error("inferrer.Add(samples) failed to merge native maps")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	if inferrer.Count() != 2 {
+		t.Error("inferrer.Add(samples) count does not match")
+		t.Errorf("Expecting %v, got %v", 2, inferrer.Count())
+		return
+	}
+}
+
+func TestInferrer_Schema(t *testing.T) {
+	inferrer := NewWithOptions(Options{EnumLimit: 0})
+	_ = inferrer.Add(object.New().Set("id", 3).Set("active", true).Set("kind", "a"),
+		object.New().Set("id", 7.0).Set("active", nil).Set("kind", "a"))
+	expecting := "{\"$schema\":\"" + Draft + "\",\"type\":\"object\",\"properties\":{" +
+		"\"active\":{\"type\":[\"null\",\"boolean\"]}," +
+		"\"id\":{\"type\":\"integer\",\"minimum\":3,\"maximum\":7}," +
+		"\"kind\":{\"type\":\"string\"}}," +
+		"\"required\":[\"active\",\"id\",\"kind\"]}"
+	if fmt.Sprint(inferrer.Schema()) != expecting {
+		t.Error("inferrer.Schema() schema does not match")
+		t.Errorf("Expecting %v, got %v", expecting, fmt.Sprint(inferrer.Schema()))
+		return
+	}
+}
@@ -0,0 +1,18 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+/*
+The Set holds unique values and remembers the original insertion order of
+the values. Two values are the same value if they are equal using the ==
+operator, the same way as Array.Includes() does, so 1 and int64(1) are
+different values, and NaN is never found in a Set.
+
+Values that cannot be compared using the == operator, such as slices and
+maps, can be added to a Set as well. They are compared using the DeepEqual()
+function from the "reflect" package instead. Looking up a comparable value
+takes constant time, while looking up a value that is not comparable takes
+linear time in the number of non-comparable values of the same type.
+*/
+package set
@@ -0,0 +1,266 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package set
+
+import (
+	"reflect"
+
+	"github.com/with-go/standard/array"
+	"github.com/with-go/standard/internal/comparison"
+)
+
+// The New() function creates a new Set. If there are values given, they
+// will be added to the new Set, ignoring the duplicates.
+func New(values ...interface{}) *Set {
+	return (&Set{}).Add(values...)
+}
+
+// The NewFromArray() function creates a new Set that holds the unique
+// elements of the given Array, in the order they first appear.
+func NewFromArray(v array.Array) *Set {
+	return New(v...)
+}
+
+// Set defines a Set Type. See "set" package documentation for more
+// information.
+type Set struct {
+	// entries holds the values in insertion order. A deleted value leaves a
+	// hole in the entries, which is removed once there are too many holes.
+	entries []entry
+	length  int
+	// indices maps each comparable value to its index in the entries.
+	indices map[interface{}]int
+	// buckets holds the indices in the entries of the non-comparable values,
+	// grouped by their type.
+	buckets map[reflect.Type][]int
+}
+
+type entry struct {
+	value     interface{}
+	isDeleted bool
+}
+
+// The Add() function adds the given values to the end of the Set, ignoring
+// any value that is already in the Set. Since the Add() function returns
+// back the same Set, you can chain the function call.
+func (set *Set) Add(values ...interface{}) *Set {
+	for _, value := range values {
+		if set.indexOf(value) != -1 {
+			continue
+		}
+		set.register(value, len(set.entries))
+		set.entries = append(set.entries, entry{value: value})
+		set.length++
+	}
+	return set
+}
+
+// The Array() function returns a new Array that holds the values of the
+// Set in insertion order.
+func (set *Set) Array() array.Array {
+	return set.Values()
+}
+
+// The Clear() function removes all values from the Set.
+func (set *Set) Clear() *Set {
+	*set = Set{}
+	return set
+}
+
+// The Delete() function removes the given values from the Set. Since the
+// Delete() function returns back the same Set, you can chain the function
+// call.
+func (set *Set) Delete(values ...interface{}) *Set {
+	for _, value := range values {
+		index := set.indexOf(value)
+		if index == -1 {
+			continue
+		}
+		set.unregister(value, index)
+		set.entries[index] = entry{isDeleted: true}
+		set.length--
+	}
+	if len(set.entries) > 32 && set.length < len(set.entries)/2 {
+		set.compact()
+	}
+	return set
+}
+
+// The Difference() function returns a new Set that holds the values of the
+// Set that are not in any of the other Sets, in insertion order.
+func (set *Set) Difference(others ...*Set) *Set {
+	return set.filter(func(value interface{}) bool {
+		for _, other := range others {
+			if other.Has(value) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// The ForEach() function executes a provided function once for each value
+// of the Set, in insertion order.
+func (set *Set) ForEach(function ForEachFunc) {
+	for _, entry := range set.entries {
+		if !entry.isDeleted {
+			function(entry.value)
+		}
+	}
+}
+
+// The Has() function returns a boolean indicating whether the given value
+// is in the Set or not.
+func (set *Set) Has(value interface{}) bool {
+	return set.indexOf(value) != -1
+}
+
+// The Intersection() function returns a new Set that holds the values of
+// the Set that are in every other Set, in insertion order.
+func (set *Set) Intersection(others ...*Set) *Set {
+	return set.filter(func(value interface{}) bool {
+		for _, other := range others {
+			if !other.Has(value) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// The IsSubsetOf() function determines whether every value of the Set is
+// in the other Set.
+func (set *Set) IsSubsetOf(other *Set) bool {
+	if set.length > other.length {
+		return false
+	}
+	for _, entry := range set.entries {
+		if !entry.isDeleted && !other.Has(entry.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// The IsSupersetOf() function determines whether every value of the other
+// Set is in the Set.
+func (set *Set) IsSupersetOf(other *Set) bool {
+	return other.IsSubsetOf(set)
+}
+
+// The Length() function returns the number of values contained inside the
+// Set.
+func (set *Set) Length() int {
+	return set.length
+}
+
+// The String() function returns a string representing the Set and its
+// values, in the same format as an Array.
+func (set *Set) String() string {
+	return set.Array().String()
+}
+
+// The SymmetricDifference() function returns a new Set that holds the
+// values of the Set that are not in the other Set, followed by the values
+// of the other Set that are not in the Set.
+func (set *Set) SymmetricDifference(other *Set) *Set {
+	difference := set.Difference(other)
+	other.ForEach(func(value interface{}) {
+		if !set.Has(value) {
+			difference.Add(value)
+		}
+	})
+	return difference
+}
+
+// The Union() function returns a new Set that holds the values of the Set
+// followed by the values of the other Sets, ignoring the duplicates.
+func (set *Set) Union(others ...*Set) *Set {
+	union := New(set.Values()...)
+	for _, other := range others {
+		union.Add(other.Values()...)
+	}
+	return union
+}
+
+// The Values() function returns a new slice that contains the values of the
+// Set in insertion order.
+func (set *Set) Values() []interface{} {
+	values := make([]interface{}, 0, set.length)
+	set.ForEach(func(value interface{}) {
+		values = append(values, value)
+	})
+	return values
+}
+
+func (set *Set) filter(function func(value interface{}) bool) *Set {
+	filtered := New()
+	set.ForEach(func(value interface{}) {
+		if function(value) {
+			filtered.Add(value)
+		}
+	})
+	return filtered
+}
+
+// The indexOf() function returns the index in the entries of the given
+// value, or -1 if the value is not in the Set.
+func (set *Set) indexOf(value interface{}) int {
+	if comparison.IsComparable(value) {
+		if index, ok := set.indices[value]; ok {
+			return index
+		}
+		return -1
+	}
+	for _, index := range set.buckets[reflect.TypeOf(value)] {
+		if reflect.DeepEqual(set.entries[index].value, value) {
+			return index
+		}
+	}
+	return -1
+}
+
+func (set *Set) register(value interface{}, index int) {
+	if comparison.IsComparable(value) {
+		if set.indices == nil {
+			set.indices = make(map[interface{}]int)
+		}
+		set.indices[value] = index
+		return
+	}
+	if set.buckets == nil {
+		set.buckets = make(map[reflect.Type][]int)
+	}
+	valueType := reflect.TypeOf(value)
+	set.buckets[valueType] = append(set.buckets[valueType], index)
+}
+
+func (set *Set) unregister(value interface{}, index int) {
+	if comparison.IsComparable(value) {
+		delete(set.indices, value)
+		return
+	}
+	valueType := reflect.TypeOf(value)
+	bucket := set.buckets[valueType]
+	for position, candidate := range bucket {
+		if candidate == index {
+			set.buckets[valueType] = append(bucket[:position:position], bucket[position+1:]...)
+			break
+		}
+	}
+	if len(set.buckets[valueType]) == 0 {
+		delete(set.buckets, valueType)
+	}
+}
+
+// The compact() function removes the holes left by deleted values.
+func (set *Set) compact() {
+	values := set.Values()
+	set.Clear()
+	set.Add(values...)
+}
+
+type ForEachFunc func (value interface{})
@@ -0,0 +1,170 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package set
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/with-go/standard/array"
+)
+
+type wrapper struct {
+	value interface{}
+}
+
+func TestNew(t *testing.T) {
+	set := New(3, 1, 3, 2, 1)
+	expected := []interface{}{3, 1, 2}
+	if !reflect.DeepEqual(set.Values(), expected) || set.Length() != 3 {
+		t.Error("New() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, set.Values())
+		return
+	}
+}
+
+func TestNewFromArray(t *testing.T) {
+	set := NewFromArray(array.New("a", "b", "a"))
+	expected := array.New("a", "b")
+	if !set.Array().Equal(expected) {
+		t.Error("NewFromArray() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, set.Array())
+		return
+	}
+}
+
+func TestSet_Add(t *testing.T) {
+	set := New().Add(1, int64(1), "1", nil, nil)
+	if set.Length() != 4 {
+		t.Error("set.Add() length does not match")
+		t.Errorf("Expecting %v, got %v", 4, set.Length())
+		return
+	}
+	nan := math.NaN()
+	set.Add(nan, nan)
+	if set.Length() != 6 || set.Has(nan) {
+		t.Error("set.Add() NaN does not match")
+		t.Errorf("Expecting %v, got %v", 6, set.Length())
+		return
+	}
+}
+
+func TestSet_Add_nonComparable(t *testing.T) {
+	set := New(
+		[]interface{}{1, 2},
+		[]interface{}{1, 2},
+		map[string]interface{}{"a": 1},
+		map[string]interface{}{"a": 1},
+		wrapper{[]int{1}},
+		wrapper{[]int{1}},
+		wrapper{1},
+		wrapper{1},
+	)
+	if set.Length() != 4 {
+		t.Error("set.Add() non-comparable length does not match")
+		t.Errorf("Expecting %v, got %v", 4, set.Length())
+		return
+	}
+	if !set.Has([]interface{}{1, 2}) || !set.Has(wrapper{[]int{1}}) || set.Has([]interface{}{2, 1}) {
+		t.Error("set.Has() non-comparable value does not match")
+		return
+	}
+	set.Delete([]interface{}{1, 2}, wrapper{[]int{1}})
+	expected := []interface{}{map[string]interface{}{"a": 1}, wrapper{1}}
+	if !reflect.DeepEqual(set.Values(), expected) {
+		t.Error("set.Delete() non-comparable value does not match")
+		t.Errorf("Expecting %v, got %v", expected, set.Values())
+		return
+	}
+}
+
+func TestSet_Delete(t *testing.T) {
+	set := New()
+	for index := 0; index < 100; index++ {
+		set.Add(index)
+	}
+	for index := 0; index < 100; index++ {
+		if index%10 != 0 {
+			set.Delete(index)
+		}
+	}
+	expected := []interface{}{0, 10, 20, 30, 40, 50, 60, 70, 80, 90}
+	if !reflect.DeepEqual(set.Values(), expected) || set.Length() != 10 {
+		t.Error("set.Delete() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, set.Values())
+		return
+	}
+	set.Add(5)
+	if !set.Has(90) || !set.Has(5) || set.Has(1) {
+		t.Error("set.Has() after set.Delete() does not match")
+		return
+	}
+}
+
+func TestSet_Difference(t *testing.T) {
+	difference := New(1, 2, 3, 4).Difference(New(2), New(4, 5))
+	expected := []interface{}{1, 3}
+	if !reflect.DeepEqual(difference.Values(), expected) {
+		t.Error("set.Difference() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, difference.Values())
+		return
+	}
+}
+
+func TestSet_Intersection(t *testing.T) {
+	intersection := New(4, 3, 2, 1).Intersection(New(1, 2, 3), New(2, 3, 4))
+	expected := []interface{}{3, 2}
+	if !reflect.DeepEqual(intersection.Values(), expected) {
+		t.Error("set.Intersection() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, intersection.Values())
+		return
+	}
+}
+
+func TestSet_IsSubsetOf(t *testing.T) {
+	if !New(1, 2).IsSubsetOf(New(2, 1, 3)) || New(1, 4).IsSubsetOf(New(1, 2, 3)) || !New().IsSubsetOf(New()) {
+		t.Error("set.IsSubsetOf() value does not match")
+		return
+	}
+}
+
+func TestSet_IsSupersetOf(t *testing.T) {
+	if !New(1, 2, 3).IsSupersetOf(New(3, 1)) || New(1, 2).IsSupersetOf(New(1, 2, 3)) {
+		t.Error("set.IsSupersetOf() value does not match")
+		return
+	}
+}
+
+func TestSet_String(t *testing.T) {
+	set := New("b", "a", "b")
+	if set.String() != "[\"b\",\"a\"]" {
+		t.Error("set.String() value does not match")
+		t.Errorf("Expecting %v, got %v", "[\"b\",\"a\"]", set.String())
+		return
+	}
+}
+
+func TestSet_SymmetricDifference(t *testing.T) {
+	difference := New(1, 2, 3).SymmetricDifference(New(4, 3, 2, 5))
+	expected := []interface{}{1, 4, 5}
+	if !reflect.DeepEqual(difference.Values(), expected) {
+		t.Error("set.SymmetricDifference() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, difference.Values())
+		return
+	}
+}
+
+func TestSet_Union(t *testing.T) {
+	original := New(1, 2)
+	union := original.Union(New(2, 3), New(4, 1))
+	expected := []interface{}{1, 2, 3, 4}
+	if !reflect.DeepEqual(union.Values(), expected) || original.Length() != 2 {
+		t.Error("set.Union() value does not match")
+		t.Errorf("Expecting %v, got %v", expected, union.Values())
+		return
+	}
+}
@@ -0,0 +1,367 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package typed
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/with-go/standard/array"
+)
+
+// The NewArray() function creates a new Array. If there are values given, it
+// will be pushed as the elements of the new Array.
+func NewArray[T any](values ...T) Array[T] {
+	return Array[T]{}.Push(values...)
+}
+
+// The ArrayOf() function converts an untyped Array into an Array of T. If an
+// element of the given Array is not a T, it will returns
+// UnexpectedValueTypeError.
+func ArrayOf[T any](v array.Array) (Array[T], error) {
+	converted := make(Array[T], len(v))
+	for index, element := range v {
+		value, ok := assert[T](element)
+		if !ok {
+			return nil, UnexpectedValueTypeError
+		}
+		converted[index] = value
+	}
+	return converted, nil
+}
+
+// The MapArray() function is the same as Array.Map() function, but the
+// provided function may return a value of another type, so an Array of T
+// is mapped into an Array of U.
+func MapArray[T any, U any](v Array[T], function func(array Array[T], index int, value T) U) Array[U] {
+	mapped := make(Array[U], len(v))
+	for index, value := range v {
+		mapped[index] = function(v, index, value)
+	}
+	return mapped
+}
+
+// Array defines the type-safe counterpart of array.Array, which only holds
+// elements of T.
+type Array[T any] []T
+
+// The Concat() function is used to merge two or more Arrays. This function
+// does not change the existing array, but instead returns a new Array, which
+// never shares its backing array with the Array or the other Arrays.
+func (array Array[T]) Concat(others ...Array[T]) Array[T] {
+	length := len(array)
+	for _, other := range others {
+		length += len(other)
+	}
+	concatenated := make(Array[T], 0, length)
+	concatenated = append(concatenated, array...)
+	for _, other := range others {
+		concatenated = append(concatenated, other...)
+	}
+	return concatenated
+}
+
+// The ConcatInPlace() function is the same as Concat() function, but
+// appends the elements of the other Arrays to the Array itself, the same
+// way as array.Array.ConcatInPlace() function does.
+func (array *Array[T]) ConcatInPlace(others ...Array[T]) {
+	for _, other := range others {
+		*array = append(*array, other...)
+	}
+}
+
+// The DeepEqual() function is the same as Equal() function but, instead of
+// comparing the elements using ==, it would use the DeepEqual() function from
+// the "reflect" package.
+func (array Array[T]) DeepEqual(other Array[T]) bool {
+	return reflect.DeepEqual(array, other)
+}
+
+// The Equal() function determines whether the Array has the same elements
+// compared to the elements of the other provided Array. Note that the
+// element and its order has to be the same for this function to returns true.
+func (array Array[T]) Equal(other Array[T]) bool {
+	if len(array) != len(other) {
+		return false
+	}
+	for index, value := range array {
+		if interface{}(other[index]) != interface{}(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// The Filter() function creates a new Array with all elements that pass
+// the test implemented by the provided function.
+func (array Array[T]) Filter(function ArrayFilterFunc[T]) Array[T] {
+	filtered := Array[T]{}
+	for index, value := range array {
+		if function(array, index, value) {
+			filtered = append(filtered, value)
+		}
+	}
+	return filtered
+}
+
+// The Find() function returns the value of the first element in the Array
+// that satisfies the provided testing function, and true. Otherwise, it
+// returns the zero value of T and false, indicating that no element passed
+// the test.
+func (array Array[T]) Find(function ArrayFindFunc[T]) (T, bool) {
+	for index, value := range array {
+		if function(array, index, value) {
+			return value, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// The FindIndex() function returns the index of the first element in the
+// Array that satisfies the provided testing function. Otherwise, it returns
+// -1, indicating that no element passed the test.
+func (array Array[T]) FindIndex(function ArrayFindIndexFunc[T]) int {
+	for index, value := range array {
+		if function(array, index, value) {
+			return index
+		}
+	}
+	return -1
+}
+
+// The ForEach() function executes a provided function once for each Array
+// element.
+func (array Array[T]) ForEach(function ArrayForEachFunc[T]) {
+	for index, value := range array {
+		function(array, index, value)
+	}
+}
+
+// The Includes() function determines whether the Array includes a certain
+// value among its entries, returning true or false as appropriate.
+func (array Array[T]) Includes(value T) bool {
+	return array.IndexOf(value) != -1
+}
+
+// The IndexOf() function returns the first index at which a given element
+// can be found in the Array, or -1 if it is not present.
+func (array Array[T]) IndexOf(value T) int {
+	for index, element := range array {
+		if interface{}(element) == interface{}(value) {
+			return index
+		}
+	}
+	return -1
+}
+
+// The Join() function creates and returns a new string by concatenating all
+// of the elements in the Array, separated by a specified separator string.
+// If the Array has only one item, then that item will be returned without
+// using the separator.
+func (array Array[T]) Join(separator string) string {
+	str := ""
+	for index, value := range array {
+		if index != 0 {
+			str += separator
+		}
+		str += fmt.Sprintf("%v", value)
+	}
+	return str
+}
+
+// The Keys() function returns a new slice of integer that contains the keys
+// for each index in the Array.
+func (array Array[T]) Keys() []int {
+	var keys []int
+	for index := range array {
+		keys = append(keys, index)
+	}
+	return keys
+}
+
+// The LastIndexOf() function returns the last index at which a given element
+// can be found in the Array, or -1 if it is not present. The Array is
+// searched backwards, starting at fromIndex. If fromIndex is less than 0 or
+// more than the last index of the Array, it will automatically be set to the
+// last index of the Array.
+func (array Array[T]) LastIndexOf(value T, fromIndex int) int {
+	if fromIndex < 0 || fromIndex > len(array)-1 {
+		fromIndex = len(array) - 1
+	}
+	for index := fromIndex; index >= 0; index-- {
+		if interface{}(array[index]) == interface{}(value) {
+			return index
+		}
+	}
+	return -1
+}
+
+// The Length() function returns the number of elements contained inside the
+// Array.
+func (array Array[T]) Length() int {
+	return len(array)
+}
+
+// The Map() function creates a new Array populated with the results of
+// calling a provided function on every element in the Array. Use MapArray()
+// function to map the elements into another type.
+func (array Array[T]) Map(function ArrayMapFunc[T]) Array[T] {
+	return MapArray(array, function)
+}
+
+// The Pop() function removes the last element from the Array and returns the
+// new Array and that last element. This function does not change the existing
+// array, and the new Array never shares its backing array with the Array. If
+// the Array is empty, the zero value of T is returned.
+func (array Array[T]) Pop() (Array[T], T) {
+	if len(array) == 0 {
+		var zero T
+		return Array[T]{}, zero
+	}
+	lastIndex := len(array) - 1
+	return array[:lastIndex].Concat(), array[lastIndex]
+}
+
+// The PopInPlace() function is the same as Pop() function, but removes the
+// last element from the Array itself, and returns that element.
+func (array *Array[T]) PopInPlace() T {
+	var zero T
+	if len(*array) == 0 {
+		return zero
+	}
+	lastIndex := len(*array) - 1
+	lastValue := (*array)[lastIndex]
+	(*array)[lastIndex] = zero
+	*array = (*array)[:lastIndex]
+	return lastValue
+}
+
+// The Push() function adds one or more elements to the end of an array and
+// returns the new Array. This function does not change the existing array,
+// and the new Array never shares its backing array with the Array.
+func (array Array[T]) Push(values ...T) Array[T] {
+	return array.Concat(values)
+}
+
+// The PushInPlace() function is the same as Push() function, but adds the
+// elements to the end of the Array itself, the same way as the built-in
+// append() function does.
+func (array *Array[T]) PushInPlace(values ...T) {
+	*array = append(*array, values...)
+}
+
+// The Reflect() function returns the array element of the given index as a
+// reflect.Value data. If there is no element saved with the given index, it
+// will returns reflect.Value of nil.
+func (array Array[T]) Reflect(index int) reflect.Value {
+	if index < 0 || index >= len(array) {
+		return reflect.ValueOf(nil)
+	}
+	return reflect.ValueOf(array[index])
+}
+
+// The Reflects() function returns the slice of reflect.Value
+func (array Array[T]) Reflects() []reflect.Value {
+	slice := make([]reflect.Value, len(array))
+	for index := range array {
+		slice[index] = array.Reflect(index)
+	}
+	return slice
+}
+
+// The Reverse() function returns a new Array with the elements in reversed
+// order. This function does not change the existing array.
+func (array Array[T]) Reverse() Array[T] {
+	reversed := make(Array[T], 0, len(array))
+	for index := len(array) - 1; index >= 0; index-- {
+		reversed = append(reversed, array[index])
+	}
+	return reversed
+}
+
+// The Shift() function removes the first element from the Array and returns
+// the new Array and that removed element. This function does not change
+// the existing array, and the new Array never shares its backing array with
+// the Array. If the Array is empty, the zero value of T is returned.
+func (array Array[T]) Shift() (Array[T], T) {
+	if len(array) == 0 {
+		var zero T
+		return Array[T]{}, zero
+	}
+	return array[1:].Concat(), array[0]
+}
+
+// The ShiftInPlace() function is the same as Shift() function, but removes
+// the first element from the Array itself, and returns that element.
+func (array *Array[T]) ShiftInPlace() T {
+	var zero T
+	if len(*array) == 0 {
+		return zero
+	}
+	firstValue := (*array)[0]
+	(*array)[0] = zero
+	*array = (*array)[1:]
+	return firstValue
+}
+
+// The Sort() function sorts the elements of the Array in place based on a
+// provided compare function, the same way as array.Array.Sort() function.
+// Elements that are compared as equal keep their original order.
+func (array Array[T]) Sort(function ArraySortFunc[T]) {
+	sort.SliceStable(array, func(i int, j int) bool {
+		return function(array[i], array[j]) < 0
+	})
+}
+
+// The String() function returns a string representing the specified Array
+// and its elements.
+func (array Array[T]) String() string {
+	b, _ := json.Marshal(array)
+	return string(b)
+}
+
+// The Unshift() function adds one or more elements to the beginning of the
+// Array and returns the new array. This function does not change the
+// existing array, and the new Array never shares its backing array with the
+// Array or the given values.
+func (array Array[T]) Unshift(values ...T) Array[T] {
+	return Array[T](values).Concat(array)
+}
+
+// The UnshiftInPlace() function is the same as Unshift() function, but adds
+// the elements to the beginning of the Array itself.
+func (array *Array[T]) UnshiftInPlace(values ...T) {
+	length := len(*array)
+	*array = append(*array, values...)
+	copy((*array)[len(values):], (*array)[:length])
+	copy(*array, values)
+}
+
+// The Untyped() function returns a new untyped array.Array that holds the
+// elements of the Array.
+func (array Array[T]) Untyped() array.Array {
+	untyped := make([]interface{}, len(array))
+	for index, value := range array {
+		untyped[index] = value
+	}
+	return untyped
+}
+
+// The Values() function returns a new slice that contains the values for
+// each index in the Array.
+func (array Array[T]) Values() []T {
+	return array
+}
+
+type ArrayFilterFunc[T any] func(array Array[T], index int, value T) bool
+type ArrayFindFunc[T any] func(array Array[T], index int, value T) bool
+type ArrayFindIndexFunc[T any] func(array Array[T], index int, value T) bool
+type ArrayForEachFunc[T any] func(array Array[T], index int, value T)
+type ArrayMapFunc[T any] func(array Array[T], index int, value T) T
+type ArraySortFunc[T any] func(a T, b T) int
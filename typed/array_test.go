@@ -0,0 +1,239 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package typed
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/with-go/standard/array"
+)
+
+func TestNewArray(t *testing.T) {
+	numbers := NewArray(1, 2, 3)
+	if numbers.Length() != 3 || numbers[2] != 3 {
+		t.Error("NewArray() value does not match")
+		t.Errorf("Expecting %v, got %v", "[1,2,3]", numbers)
+		return
+	}
+}
+
+func TestArrayOf(t *testing.T) {
+	numbers, err := ArrayOf[int](array.New(1, 2, 3))
+	if err != nil {
+		t.Error("ArrayOf() failed to convert Array")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	if !numbers.Equal(NewArray(1, 2, 3)) {
+		t.Error("ArrayOf() value does not match")
+		t.Errorf("Expecting %v, got %v", "[1,2,3]", numbers)
+		return
+	}
+	if _, err := ArrayOf[int](array.New(1, "2")); err != UnexpectedValueTypeError {
+		t.Error("ArrayOf() does not return UnexpectedValueTypeError")
+		t.Errorf("Expecting %v, got %v", UnexpectedValueTypeError, err)
+		return
+	}
+	if _, err := ArrayOf[int](array.New(1, nil)); err != UnexpectedValueTypeError {
+		t.Error("ArrayOf() does not reject nil for a non-nilable type")
+		t.Errorf("Expecting %v, got %v", UnexpectedValueTypeError, err)
+		return
+	}
+	pointers, err := ArrayOf[*int](array.New(nil))
+	if err != nil || pointers[0] != nil {
+		t.Error("ArrayOf() does not accept nil for a pointer type")
+		t.Errorf("Expecting %v, got %v", nil, err)
+		return
+	}
+}
+
+func TestMapArray(t *testing.T) {
+	labels := MapArray(NewArray(1, 2), func(array Array[int], index int, value int) string {
+		return "#" + strconv.Itoa(value)
+	})
+	if !reflect.DeepEqual(labels, NewArray("#1", "#2")) {
+		t.Error("MapArray() value does not match")
+		t.Errorf("Expecting %v, got %v", "[\"#1\",\"#2\"]", labels)
+		return
+	}
+}
+
+func TestArray_Concat(t *testing.T) {
+	numbers := make(Array[int], 2, 10)
+	concatenated := numbers.Concat(NewArray(3), NewArray(4))
+	if !concatenated.Equal(NewArray(0, 0, 3, 4)) {
+		t.Error("array.Concat() value does not match")
+		t.Errorf("Expecting %v, got %v", "[0,0,3,4]", concatenated)
+		return
+	}
+	if numbers.Push(5)[2] != 5 || concatenated[2] != 3 {
+		t.Error("array.Concat() shares its elements with the Array")
+		t.Errorf("Expecting %v, got %v", 3, concatenated[2])
+		return
+	}
+}
+
+func TestArray_Filter(t *testing.T) {
+	filtered := NewArray(1, 2, 3, 4).Filter(func(array Array[int], index int, value int) bool {
+		return value%2 == 0
+	})
+	if !filtered.Equal(NewArray(2, 4)) {
+		t.Error("array.Filter() value does not match")
+		t.Errorf("Expecting %v, got %v", "[2,4]", filtered)
+		return
+	}
+}
+
+func TestArray_Find(t *testing.T) {
+	numbers := NewArray(1, 2, 3)
+	if value, ok := numbers.Find(func(array Array[int], index int, value int) bool {
+		return value > 1
+	}); !ok || value != 2 {
+		t.Error("array.Find() value does not match")
+		t.Errorf("Expecting %v, got %v", 2, value)
+		return
+	}
+	if value, ok := numbers.Find(func(array Array[int], index int, value int) bool {
+		return value > 3
+	}); ok || value != 0 {
+		t.Error("array.Find() does not return false if nothing is found")
+		t.Errorf("Expecting %v, got %v", 0, value)
+		return
+	}
+}
+
+func TestArray_IndexOf(t *testing.T) {
+	words := NewArray("a", "b", "a")
+	if words.IndexOf("a") != 0 || words.LastIndexOf("a", -1) != 2 || words.IndexOf("c") != -1 {
+		t.Error("array.IndexOf() value does not match")
+		t.Errorf("Expecting %v, got %v", 0, words.IndexOf("a"))
+		return
+	}
+	if !words.Includes("b") || words.Includes("c") {
+		t.Error("array.Includes() value does not match")
+		t.Errorf("Expecting %v, got %v", true, words.Includes("b"))
+		return
+	}
+}
+
+func TestArray_Join(t *testing.T) {
+	if joined := NewArray(1, 2, 3).Join(", "); joined != "1, 2, 3" {
+		t.Error("array.Join() value does not match")
+		t.Errorf("Expecting %v, got %v", "1, 2, 3", joined)
+		return
+	}
+}
+
+func TestArray_Map(t *testing.T) {
+	mapped := NewArray(1, 2, 3).Map(func(array Array[int], index int, value int) int {
+		return value * 10
+	})
+	if !mapped.Equal(NewArray(10, 20, 30)) {
+		t.Error("array.Map() value does not match")
+		t.Errorf("Expecting %v, got %v", "[10,20,30]", mapped)
+		return
+	}
+}
+
+func TestArray_Pop(t *testing.T) {
+	rest, last := NewArray(1, 2).Pop()
+	if last != 2 || !rest.Equal(NewArray(1)) {
+		t.Error("array.Pop() value does not match")
+		t.Errorf("Expecting %v, got %v", 2, last)
+		return
+	}
+	if _, last := NewArray[int]().Pop(); last != 0 {
+		t.Error("array.Pop() does not return the zero value")
+		t.Errorf("Expecting %v, got %v", 0, last)
+		return
+	}
+}
+
+func TestArray_Reverse(t *testing.T) {
+	if reversed := NewArray(1, 2, 3).Reverse(); !reversed.Equal(NewArray(3, 2, 1)) {
+		t.Error("array.Reverse() value does not match")
+		t.Errorf("Expecting %v, got %v", "[3,2,1]", reversed)
+		return
+	}
+}
+
+func TestArray_Shift(t *testing.T) {
+	rest, first := NewArray(1, 2).Shift()
+	if first != 1 || !rest.Equal(NewArray(2)) {
+		t.Error("array.Shift() value does not match")
+		t.Errorf("Expecting %v, got %v", 1, first)
+		return
+	}
+}
+
+func TestArray_Sort(t *testing.T) {
+	words := NewArray("bb", "a", "cc", "d")
+	words.Sort(func(a string, b string) int {
+		return len(a) - len(b)
+	})
+	if !words.Equal(NewArray("a", "d", "bb", "cc")) {
+		t.Error("array.Sort() value does not match")
+		t.Errorf("Expecting %v, got %v", "[\"a\",\"d\",\"bb\",\"cc\"]", words)
+		return
+	}
+}
+
+func TestArray_String(t *testing.T) {
+	if str := NewArray("a", "b").String(); str != "[\"a\",\"b\"]" {
+		t.Error("array.String() value does not match")
+		t.Errorf("Expecting %v, got %v", "[\"a\",\"b\"]", str)
+		return
+	}
+}
+
+func TestArray_Unshift(t *testing.T) {
+	numbers := make(Array[int], 1, 10)
+	unshifted := numbers.Unshift(1, 2)
+	if !unshifted.Equal(NewArray(1, 2, 0)) {
+		t.Error("array.Unshift() value does not match")
+		t.Errorf("Expecting %v, got %v", "[1,2,0]", unshifted)
+		return
+	}
+}
+
+func TestArray_Untyped(t *testing.T) {
+	untyped := NewArray(1, 2).Untyped()
+	if !untyped.Equal(array.New(1, 2)) {
+		t.Error("array.Untyped() value does not match")
+		t.Errorf("Expecting %v, got %v", "[1,2]", untyped)
+		return
+	}
+}
+
+func TestArray_PopInPlace(t *testing.T) {
+	numbers := NewArray(1, 2, 3)
+	popped, _ := numbers.Pop()
+	popped.PushInPlace(9)
+	if numbers[2] != 3 {
+		t.Error("array.Pop() result shares its backing array with the Array")
+		t.Errorf("Expecting %v, got %v", 3, numbers[2])
+		return
+	}
+	if last := numbers.PopInPlace(); last != 3 || !numbers.Equal(NewArray(1, 2)) {
+		t.Error("array.PopInPlace() value does not match")
+		t.Errorf("Expecting %v, got %v", 3, last)
+		return
+	}
+	if first := numbers.ShiftInPlace(); first != 1 || !numbers.Equal(NewArray(2)) {
+		t.Error("array.ShiftInPlace() value does not match")
+		t.Errorf("Expecting %v, got %v", 1, first)
+		return
+	}
+	numbers.UnshiftInPlace(0, 1)
+	numbers.ConcatInPlace(NewArray(3))
+	if !numbers.Equal(NewArray(0, 1, 2, 3)) {
+		t.Error("array.UnshiftInPlace() value does not match")
+		t.Errorf("Expecting %v, got %v", "[0,1,2,3]", numbers)
+		return
+	}
+}
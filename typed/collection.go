@@ -0,0 +1,358 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package typed
+
+import (
+	"reflect"
+
+	"github.com/with-go/standard/collection"
+)
+
+// The NewCollection() function creates a new Collection.
+func NewCollection[K comparable, V any]() *Collection[K, V] {
+	return &Collection[K, V]{collection.New()}
+}
+
+// The CollectionOf() function converts an untyped Collection into a
+// Collection of K and V, keeping the insertion order. If a key of the given
+// Collection is not a K, it will returns UnexpectedKeyTypeError, and if a
+// value is not a V, it will returns UnexpectedValueTypeError. A nil
+// Collection is converted into an empty Collection, the same way as
+// ArrayOf() and ObjectOf() functions convert a nil Array and Object.
+func CollectionOf[K comparable, V any](v *collection.Collection) (*Collection[K, V], error) {
+	converted := NewCollection[K, V]()
+	if v == nil {
+		return converted, nil
+	}
+	for _, pair := range v.Pairs() {
+		key, ok := assert[K](pair.Key())
+		if !ok {
+			return nil, UnexpectedKeyTypeError
+		}
+		value, ok := assert[V](pair.Value())
+		if !ok {
+			return nil, UnexpectedValueTypeError
+		}
+		converted.Set(key, value)
+	}
+	return converted, nil
+}
+
+// The MapCollection() function creates a new Collection with the same keys in
+// the same order, populated with the results of calling a provided function
+// on every element in the Collection. The provided function may return a
+// value of another type, so a Collection of V is mapped into a Collection
+// of U.
+func MapCollection[K comparable, V any, U any](v *Collection[K, V], function func(key K, value V) U) *Collection[K, U] {
+	mapped := NewCollection[K, U]()
+	v.ForEach(func(key K, value V) {
+		mapped.Set(key, function(key, value))
+	})
+	return mapped
+}
+
+// Collection defines the type-safe counterpart of collection.Collection,
+// which only holds keys of K and values of V. Keys are compared the same way
+// as collection.Collection does. A Collection should be created using the
+// NewCollection() or CollectionOf() function.
+type Collection[K comparable, V any] struct {
+	untyped *collection.Collection
+}
+
+// The Add() function adds or updates an element with a specified key and
+// value to the Collection, the same way as collection.Collection.Add()
+// function does.
+func (collection *Collection[K, V]) Add(key K, value V) *Collection[K, V] {
+	collection.untyped.Add(key, value)
+	return collection
+}
+
+// The At() function returns a pointer to the Pair{} at the given index of
+// the insertion order. A negative index counts back from the end of the
+// Collection. If the index is out of range, it will returns nil.
+func (collection *Collection[K, V]) At(index int) *Pair[K, V] {
+	return pairOf[K, V](collection.untyped.At(index))
+}
+
+// The Clear() function removes all elements from the Collection.
+func (collection *Collection[K, V]) Clear() *Collection[K, V] {
+	collection.untyped.Clear()
+	return collection
+}
+
+// The Delete() function removes the specified element from the Collection by
+// key.
+func (collection *Collection[K, V]) Delete(key K) *Collection[K, V] {
+	collection.untyped.Delete(key)
+	return collection
+}
+
+// The First() function returns a pointer to the first Pair{} in the
+// insertion order. If the Collection is empty, it will returns nil.
+func (collection *Collection[K, V]) First() *Pair[K, V] {
+	return pairOf[K, V](collection.untyped.First())
+}
+
+// The ForEach() function executes a provided function once for each
+// Collection element, based on the insertion order.
+func (collection *Collection[K, V]) ForEach(function CollectionForEachFunc[K, V]) {
+	collection.untyped.ForEach(func(key interface{}, value interface{}) {
+		function(valueOf[K](key), valueOf[V](value))
+	})
+}
+
+// The Get() function returns a specified element from the Collection. If the
+// element with the given key does not exist, it will returns the zero value
+// of V.
+func (collection *Collection[K, V]) Get(key K) V {
+	return valueOf[V](collection.untyped.Get(key))
+}
+
+// The Has() function returns a boolean indicating whether an element with
+// the specified key exists or not.
+func (collection *Collection[K, V]) Has(key K) bool {
+	return collection.untyped.Has(key)
+}
+
+// The HasAll() function is the same as Has() function, but accepts a slice
+// of keys instead of a single key. If the Collection has all the element
+// for each given key, it will returns true.
+func (collection *Collection[K, V]) HasAll(keys ...K) bool {
+	for _, key := range keys {
+		if !collection.Has(key) {
+			return false
+		}
+	}
+	return true
+}
+
+// The HasSome() function is the same as Has() function, but accepts a slice
+// of keys instead of a single key. If the Collection has one or more element
+// for each given key, it will returns true.
+func (collection *Collection[K, V]) HasSome(keys ...K) bool {
+	for _, key := range keys {
+		if collection.Has(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// The IndexOf() function returns the index of the given key in the insertion
+// order. If the key does not exist, it will returns -1.
+func (collection *Collection[K, V]) IndexOf(key K) int {
+	return collection.untyped.IndexOf(key)
+}
+
+// The InsertAt() function inserts an element at the given index of the
+// insertion order, the same way as collection.Collection.InsertAt()
+// function does.
+func (collection *Collection[K, V]) InsertAt(index int, key K, value V) *Collection[K, V] {
+	collection.untyped.InsertAt(index, key, value)
+	return collection
+}
+
+// The Keys() function returns a slice that contains the keys for each
+// element in the Collection, based on the insertion order.
+func (collection *Collection[K, V]) Keys() []K {
+	var keys []K
+	for _, key := range collection.untyped.Keys() {
+		keys = append(keys, valueOf[K](key))
+	}
+	return keys
+}
+
+// The Last() function returns a pointer to the last Pair{} in the insertion
+// order. If the Collection is empty, it will returns nil.
+func (collection *Collection[K, V]) Last() *Pair[K, V] {
+	return pairOf[K, V](collection.untyped.Last())
+}
+
+// The Length() function returns the number of elements contained inside the
+// Collection.
+func (collection *Collection[K, V]) Length() int {
+	return collection.untyped.Length()
+}
+
+// The MoveAfter() function moves the element of the given key right after
+// the element of the anchor key.
+func (collection *Collection[K, V]) MoveAfter(key K, anchor K) *Collection[K, V] {
+	collection.untyped.MoveAfter(key, anchor)
+	return collection
+}
+
+// The MoveBefore() function moves the element of the given key right before
+// the element of the anchor key.
+func (collection *Collection[K, V]) MoveBefore(key K, anchor K) *Collection[K, V] {
+	collection.untyped.MoveBefore(key, anchor)
+	return collection
+}
+
+// The MoveToBack() function moves the element of the given key to the end of
+// the insertion order.
+func (collection *Collection[K, V]) MoveToBack(key K) *Collection[K, V] {
+	collection.untyped.MoveToBack(key)
+	return collection
+}
+
+// The MoveToFront() function moves the element of the given key to the
+// beginning of the insertion order.
+func (collection *Collection[K, V]) MoveToFront(key K) *Collection[K, V] {
+	collection.untyped.MoveToFront(key)
+	return collection
+}
+
+// The NextKey() function returns the key that comes right after the given
+// key in the insertion order. If the given key does not exist or it is the
+// last key, it will returns the zero value of K and false.
+func (collection *Collection[K, V]) NextKey(key K) (K, bool) {
+	next, ok := collection.untyped.NextKey(key)
+	return valueOf[K](next), ok
+}
+
+// The PairOf() function returns a pointer to the Pair{} that represent the
+// given key. If there are no Pair{} registered with the given key, it will
+// returns nil.
+func (collection *Collection[K, V]) PairOf(key K) *Pair[K, V] {
+	return pairOf[K, V](collection.untyped.PairOf(key))
+}
+
+// The Pairs() function returns a slice of pointer to each Pair{} in the
+// Collection, based on the insertion order.
+func (collection *Collection[K, V]) Pairs() []*Pair[K, V] {
+	var pairs []*Pair[K, V]
+	for _, pair := range collection.untyped.Pairs() {
+		pairs = append(pairs, pairOf[K, V](pair))
+	}
+	return pairs
+}
+
+// The Pop() function removes the last element from the Collection and
+// returns its Pair{}. If the Collection is empty, it will returns nil.
+func (collection *Collection[K, V]) Pop() *Pair[K, V] {
+	return pairOf[K, V](collection.untyped.Pop())
+}
+
+// The PrevKey() function returns the key that comes right before the given
+// key in the insertion order. If the given key does not exist or it is the
+// first key, it will returns the zero value of K and false.
+func (collection *Collection[K, V]) PrevKey(key K) (K, bool) {
+	previous, ok := collection.untyped.PrevKey(key)
+	return valueOf[K](previous), ok
+}
+
+// The Reflect() function returns the Collection value of the given key as a
+// reflect.Value data. If there is no element saved with the given key, it
+// will returns reflect.Value of nil.
+func (collection *Collection[K, V]) Reflect(key K) reflect.Value {
+	return collection.untyped.Reflect(key)
+}
+
+// The Reflects() function returns the map[string]reflect.Value representation
+// of the Collection.
+func (collection *Collection[K, V]) Reflects() map[string]reflect.Value {
+	return collection.untyped.Reflects()
+}
+
+// The Rename() function changes the key of an element without changing its
+// position in the insertion order, the same way as
+// collection.Collection.Rename() function does.
+func (collection *Collection[K, V]) Rename(oldKey K, newKey K) *Collection[K, V] {
+	collection.untyped.Rename(oldKey, newKey)
+	return collection
+}
+
+// The Set() function adds or updates an element with a specified key and
+// value to the Collection, without changing the order of insertion of an
+// existing key.
+func (collection *Collection[K, V]) Set(key K, value V) *Collection[K, V] {
+	collection.untyped.Set(key, value)
+	return collection
+}
+
+// The Shift() function removes the first element from the Collection and
+// returns its Pair{}. If the Collection is empty, it will returns nil.
+func (collection *Collection[K, V]) Shift() *Pair[K, V] {
+	return pairOf[K, V](collection.untyped.Shift())
+}
+
+// The Slice() function returns a new Collection that holds the elements
+// from the start index up to, but not including, the end index of the
+// insertion order, the same way as collection.Collection.Slice() function
+// does.
+func (collection *Collection[K, V]) Slice(start int, end int) *Collection[K, V] {
+	return &Collection[K, V]{collection.untyped.Slice(start, end)}
+}
+
+// The String() function returns a string representing the specified
+// Collection and its elements.
+func (collection *Collection[K, V]) String() string {
+	return collection.untyped.String()
+}
+
+// The Swap() function swaps the positions of two elements in the insertion
+// order.
+func (collection *Collection[K, V]) Swap(a K, b K) *Collection[K, V] {
+	collection.untyped.Swap(a, b)
+	return collection
+}
+
+// The Untyped() function returns a new untyped collection.Collection that
+// holds the elements of the Collection in the same order. Changes to the
+// returned Collection will not be reflected to the Collection.
+func (collection *Collection[K, V]) Untyped() *collection.Collection {
+	return collection.untyped.Slice(0, collection.untyped.Length())
+}
+
+// The Values() function returns a slice that contains the values for each
+// element in the Collection, based on the insertion order.
+func (collection *Collection[K, V]) Values() []V {
+	var values []V
+	for _, value := range collection.untyped.Values() {
+		values = append(values, valueOf[V](value))
+	}
+	return values
+}
+
+// Pair defines the type-safe counterpart of collection.Pair, the key-value
+// pair of an element in a Collection of K and V.
+type Pair[K comparable, V any] struct {
+	untyped *collection.Pair
+}
+
+func pairOf[K comparable, V any](untyped *collection.Pair) *Pair[K, V] {
+	if untyped == nil {
+		return nil
+	}
+	return &Pair[K, V]{untyped}
+}
+
+// The Index() function returns the index of the Pair{} in the insertion
+// order of the Collection it belongs to. If the Pair{} has been removed
+// from its Collection, it will returns -1.
+func (pair *Pair[K, V]) Index() int {
+	return pair.untyped.Index()
+}
+
+// The Key() function returns the key of the Pair{}.
+func (pair *Pair[K, V]) Key() K {
+	return valueOf[K](pair.untyped.Key())
+}
+
+// The SetValue() function updates the value of the Pair{}. If the Pair{}
+// belongs to a Collection, the Collection will be updated as well, without
+// changing the order of insertion.
+func (pair *Pair[K, V]) SetValue(value V) *Pair[K, V] {
+	pair.untyped.SetValue(value)
+	return pair
+}
+
+// The Value() function returns the value of the Pair{}.
+func (pair *Pair[K, V]) Value() V {
+	return valueOf[V](pair.untyped.Value())
+}
+
+type CollectionForEachFunc[K comparable, V any] func(key K, value V)
@@ -0,0 +1,137 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package typed
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/with-go/standard/collection"
+)
+
+func TestCollectionOf(t *testing.T) {
+	scores, err := CollectionOf[string, int](collection.New().Set("b", 2).Set("a", 1))
+	if err != nil {
+		t.Error("CollectionOf() failed to convert Collection")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	if !reflect.DeepEqual(scores.Keys(), []string{"b", "a"}) || scores.Get("a") != 1 {
+		t.Error("CollectionOf() value does not match")
+		t.Errorf("Expecting %v, got %v", "{\"b\":2,\"a\":1}", scores)
+		return
+	}
+	if _, err := CollectionOf[string, int](collection.New().Set(1, 1)); err != UnexpectedKeyTypeError {
+		t.Error("CollectionOf() does not return UnexpectedKeyTypeError")
+		t.Errorf("Expecting %v, got %v", UnexpectedKeyTypeError, err)
+		return
+	}
+	if _, err := CollectionOf[string, int](collection.New().Set("a", "1")); err != UnexpectedValueTypeError {
+		t.Error("CollectionOf() does not return UnexpectedValueTypeError")
+		t.Errorf("Expecting %v, got %v", UnexpectedValueTypeError, err)
+		return
+	}
+	empty, err := CollectionOf[string, int](nil)
+	if err != nil || empty.Length() != 0 {
+		t.Error("CollectionOf() nil value does not match")
+		t.Errorf("Expecting %v, got %v", 0, err)
+		return
+	}
+}
+
+func TestMapCollection(t *testing.T) {
+	mapped := MapCollection(NewCollection[int, int]().Set(2, 20).Set(1, 10), func(key int, value int) string {
+		return string(rune('a' + key))
+	})
+	if !reflect.DeepEqual(mapped.Values(), []string{"c", "b"}) {
+		t.Error("MapCollection() value does not match")
+		t.Errorf("Expecting %v, got %v", []string{"c", "b"}, mapped.Values())
+		return
+	}
+}
+
+func TestCollection_ForEach(t *testing.T) {
+	var keys []int
+	total := 0
+	NewCollection[int, int]().Set(3, 30).Set(1, 10).ForEach(func(key int, value int) {
+		keys = append(keys, key)
+		total += value
+	})
+	if !reflect.DeepEqual(keys, []int{3, 1}) || total != 40 {
+		t.Error("collection.ForEach() value does not match")
+		t.Errorf("Expecting %v, got %v", []int{3, 1}, keys)
+		return
+	}
+}
+
+func TestCollection_Get(t *testing.T) {
+	pointers := NewCollection[string, *int]().Set("nil", nil)
+	if pointers.Get("nil") != nil || pointers.Get("missing") != nil || !pointers.Has("nil") {
+		t.Error("collection.Get() value does not match")
+		t.Errorf("Expecting %v, got %v", nil, pointers.Get("nil"))
+		return
+	}
+}
+
+func TestCollection_NextKey(t *testing.T) {
+	scores := NewCollection[string, int]().Set("a", 1).Set("b", 2)
+	if key, ok := scores.NextKey("a"); !ok || key != "b" {
+		t.Error("collection.NextKey() value does not match")
+		t.Errorf("Expecting %v, got %v", "b", key)
+		return
+	}
+	if key, ok := scores.PrevKey("a"); ok || key != "" {
+		t.Error("collection.PrevKey() does not return the zero value")
+		t.Errorf("Expecting %v, got %v", "", key)
+		return
+	}
+}
+
+func TestCollection_Pairs(t *testing.T) {
+	scores := NewCollection[string, int]().Set("a", 1).Set("b", 2)
+	pairs := scores.Pairs()
+	pairs[1].SetValue(20)
+	if len(pairs) != 2 || pairs[1].Key() != "b" || pairs[1].Index() != 1 || scores.Get("b") != 20 {
+		t.Error("collection.Pairs() value does not match")
+		t.Errorf("Expecting %v, got %v", "{\"a\":1,\"b\":20}", scores)
+		return
+	}
+	if pair := scores.Pop(); pair.Value() != 20 || pair.Index() != -1 || scores.Pop().Key() != "a" || scores.Pop() != nil {
+		t.Error("collection.Pop() value does not match")
+		t.Errorf("Expecting %v, got %v", 20, pair.Value())
+		return
+	}
+}
+
+func TestCollection_Slice(t *testing.T) {
+	scores := NewCollection[string, int]().Set("a", 1).Set("b", 2).Set("c", 3)
+	sliced := scores.Slice(1, 3)
+	sliced.Set("b", 20)
+	if !reflect.DeepEqual(sliced.Keys(), []string{"b", "c"}) || scores.Get("b") != 2 {
+		t.Error("collection.Slice() value does not match")
+		t.Errorf("Expecting %v, got %v", "{\"b\":20,\"c\":3}", sliced)
+		return
+	}
+}
+
+func TestCollection_String(t *testing.T) {
+	if str := NewCollection[string, int]().Set("b", 2).Set("a", 1).String(); str != "{\"b\":2,\"a\":1}" {
+		t.Error("collection.String() value does not match")
+		t.Errorf("Expecting %v, got %v", "{\"b\":2,\"a\":1}", str)
+		return
+	}
+}
+
+func TestCollection_Untyped(t *testing.T) {
+	scores := NewCollection[string, int]().Set("b", 2).Set("a", 1)
+	untyped := scores.Untyped()
+	untyped.Set("c", "3")
+	if !reflect.DeepEqual(untyped.Keys(), []interface{}{"b", "a", "c"}) || scores.Has("c") {
+		t.Error("collection.Untyped() value does not match")
+		t.Errorf("Expecting %v, got %v", "{\"b\":2,\"a\":1,\"c\":\"3\"}", untyped)
+		return
+	}
+}
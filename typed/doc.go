@@ -0,0 +1,26 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+/*
+Package typed provides the type-safe counterparts of the Array, Object and
+Collection types, using type parameters instead of interface{}. An element
+read from a typed value does not need a type assertion, and a value of the
+wrong type is rejected at compile time.
+
+	scores := typed.NewArray(90, 75, 82)
+	passed := scores.Filter(func(array typed.Array[int], index int, value int) bool {
+		return value >= 80
+	})
+
+The typed values offer the same functions as their untyped counterparts.
+Functions that change the element type, such as mapping an Array[int] into
+an Array[string], are provided as package functions, because a method cannot
+have type parameters of its own.
+
+The ArrayOf(), ObjectOf() and CollectionOf() functions convert an untyped
+value into a typed value, and the Untyped() function of every typed value
+converts it back, so a codebase can be migrated package by package.
+*/
+package typed
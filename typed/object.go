@@ -0,0 +1,188 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package typed
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"github.com/with-go/standard/object"
+)
+
+// The NewObject() function creates a new Object.
+func NewObject[V any]() Object[V] {
+	return Object[V]{}
+}
+
+// The ObjectOf() function converts an untyped Object into an Object of V. If
+// a value of the given Object is not a V, it will returns
+// UnexpectedValueTypeError.
+func ObjectOf[V any](v object.Object) (Object[V], error) {
+	converted := make(Object[V], len(v))
+	for key, element := range v {
+		value, ok := assert[V](element)
+		if !ok {
+			return nil, UnexpectedValueTypeError
+		}
+		converted[key] = value
+	}
+	return converted, nil
+}
+
+// The MapObject() function creates a new Object with the same keys, populated
+// with the results of calling a provided function on every element in the
+// Object. The provided function may return a value of another type, so an
+// Object of V is mapped into an Object of U.
+func MapObject[V any, U any](v Object[V], function func(key string, value V) U) Object[U] {
+	mapped := make(Object[U], len(v))
+	for key, value := range v {
+		mapped[key] = function(key, value)
+	}
+	return mapped
+}
+
+// Object defines the type-safe counterpart of object.Object, which only holds
+// values of V.
+type Object[V any] map[string]V
+
+// The Clear() function removes all elements from the Object.
+func (object Object[V]) Clear() Object[V] {
+	for key := range object {
+		delete(object, key)
+	}
+	return object
+}
+
+// The Delete() function removes the specified element from the Object by key.
+func (object Object[V]) Delete(key string) Object[V] {
+	delete(object, key)
+	return object
+}
+
+// The ForEach() function executes a provided function once for each Object
+// element, in the alphabetical order of the keys.
+func (object Object[V]) ForEach(function ObjectForEachFunc[V]) {
+	for _, key := range object.Keys() {
+		function(key, object[key])
+	}
+}
+
+// The Get() function returns a specified element from the Object.
+//
+// If the element with the given key does not exist, it will returns the
+// zero value of V.
+func (object Object[V]) Get(key string) V {
+	return object[key]
+}
+
+// The Has() function returns a boolean indicating whether an element with
+// the specified key exists or not.
+func (object Object[V]) Has(key string) bool {
+	_, exists := object[key]
+	return exists
+}
+
+// The HasAll() function is the same as Has() function, but accepts a slice
+// of keys instead of a single key string. If the object has all the element
+// for each given key, it will returns true. Otherwise, it will returns
+// false.
+func (object Object[V]) HasAll(keys ...string) bool {
+	for _, key := range keys {
+		if !object.Has(key) {
+			return false
+		}
+	}
+	return true
+}
+
+// The HasSome() function is the same as Has() function, but accepts a slice
+// of keys instead of a single key string. If the object has one or more
+// element for each given key, it will returns true. Otherwise, it will
+// return false.
+func (object Object[V]) HasSome(keys ...string) bool {
+	for _, key := range keys {
+		if object.Has(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// The Keys() function returns a slice of string that contains the keys
+// for each element in the Object, sorted alphabetically.
+func (object Object[V]) Keys() []string {
+	var keys []string
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// The Length() function returns the number of elements contained inside the
+// Object.
+func (object Object[V]) Length() int {
+	return len(object)
+}
+
+// The Reflect() function returns the Object value of the given key as a
+// reflect.Value data. If there is no element saved with the given key, it
+// will returns reflect.Value of nil.
+func (object Object[V]) Reflect(key string) reflect.Value {
+	if !object.Has(key) {
+		return reflect.ValueOf(nil)
+	}
+	return reflect.ValueOf(object[key])
+}
+
+// The Reflects() function returns the map[string]reflect.Value representation
+// of the Object.
+func (object Object[V]) Reflects() map[string]reflect.Value {
+	reflection := make(map[string]reflect.Value)
+	for key := range object {
+		reflection[key] = object.Reflect(key)
+	}
+	return reflection
+}
+
+// The Set() function adds or updates an element with a specified key and value
+// to the Object. Since the Set() function returns back the same Object, you
+// can chain the function call.
+func (object Object[V]) Set(key string, value V) Object[V] {
+	object[key] = value
+	return object
+}
+
+// The String() function returns a string representing the specified Object
+// and its elements.
+func (object Object[V]) String() string {
+	b, _ := json.Marshal(object)
+	return string(b)
+}
+
+// The Untyped() function returns a new untyped object.Object that holds the
+// elements of the Object.
+func (object Object[V]) Untyped() object.Object {
+	untyped := make(map[string]interface{}, len(object))
+	for key, value := range object {
+		untyped[key] = value
+	}
+	return untyped
+}
+
+// The Values() function returns a slice that contains the values for each
+// element in the Object, ordered based on the keys that sorted
+// alphabetically.
+func (object Object[V]) Values() []V {
+	var values []V
+	for _, key := range object.Keys() {
+		values = append(values, object[key])
+	}
+	return values
+}
+
+type ObjectForEachFunc[V any] func(key string, value V)
@@ -0,0 +1,100 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package typed
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/with-go/standard/object"
+)
+
+func TestObjectOf(t *testing.T) {
+	scores, err := ObjectOf[int](object.New().Set("a", 1).Set("b", 2))
+	if err != nil {
+		t.Error("ObjectOf() failed to convert Object")
+		t.Errorf("Reason: %s", err.Error())
+		return
+	}
+	if scores.Get("b") != 2 {
+		t.Error("ObjectOf() value does not match")
+		t.Errorf("Expecting %v, got %v", 2, scores.Get("b"))
+		return
+	}
+	if _, err := ObjectOf[int](object.New().Set("a", "1")); err != UnexpectedValueTypeError {
+		t.Error("ObjectOf() does not return UnexpectedValueTypeError")
+		t.Errorf("Expecting %v, got %v", UnexpectedValueTypeError, err)
+		return
+	}
+}
+
+func TestMapObject(t *testing.T) {
+	mapped := MapObject(NewObject[int]().Set("a", 1), func(key string, value int) bool {
+		return value > 0
+	})
+	if mapped.Get("a") != true {
+		t.Error("MapObject() value does not match")
+		t.Errorf("Expecting %v, got %v", true, mapped.Get("a"))
+		return
+	}
+}
+
+func TestObject_Delete(t *testing.T) {
+	scores := NewObject[int]().Set("a", 1).Set("b", 2).Delete("a")
+	if scores.Has("a") || !scores.HasAll("b") || scores.HasSome("a", "c") {
+		t.Error("object.Delete() value does not match")
+		t.Errorf("Expecting %v, got %v", "{\"b\":2}", scores)
+		return
+	}
+}
+
+func TestObject_ForEach(t *testing.T) {
+	var keys []string
+	total := 0
+	NewObject[int]().Set("b", 2).Set("a", 1).ForEach(func(key string, value int) {
+		keys = append(keys, key)
+		total += value
+	})
+	if !reflect.DeepEqual(keys, []string{"a", "b"}) || total != 3 {
+		t.Error("object.ForEach() value does not match")
+		t.Errorf("Expecting %v, got %v", []string{"a", "b"}, keys)
+		return
+	}
+}
+
+func TestObject_Get(t *testing.T) {
+	scores := NewObject[int]().Set("a", 1)
+	if scores.Get("a") != 1 || scores.Get("b") != 0 {
+		t.Error("object.Get() value does not match")
+		t.Errorf("Expecting %v, got %v", 1, scores.Get("a"))
+		return
+	}
+}
+
+func TestObject_String(t *testing.T) {
+	if str := NewObject[int]().Set("b", 2).Set("a", 1).String(); str != "{\"a\":1,\"b\":2}" {
+		t.Error("object.String() value does not match")
+		t.Errorf("Expecting %v, got %v", "{\"a\":1,\"b\":2}", str)
+		return
+	}
+}
+
+func TestObject_Untyped(t *testing.T) {
+	untyped := NewObject[int]().Set("a", 1).Untyped()
+	if untyped.Get("a") != 1 {
+		t.Error("object.Untyped() value does not match")
+		t.Errorf("Expecting %v, got %v", 1, untyped.Get("a"))
+		return
+	}
+}
+
+func TestObject_Values(t *testing.T) {
+	if values := NewObject[int]().Set("b", 2).Set("a", 1).Values(); !reflect.DeepEqual(values, []int{1, 2}) {
+		t.Error("object.Values() value does not match")
+		t.Errorf("Expecting %v, got %v", []int{1, 2}, values)
+		return
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package typed
+
+import (
+	"errors"
+	"reflect"
+)
+
+var (
+	UnexpectedKeyTypeError = errors.New("the given value has a key of an unexpected type, " +
+		"every key should be of the key type parameter")
+	UnexpectedValueTypeError = errors.New("the given value has an element of an unexpected type, " +
+		"every element should be of the value type parameter")
+)
+
+// The assert() function converts v into T. A nil v is only converted if T
+// can hold nil, such as a pointer, a slice or an interface type.
+func assert[T any](v interface{}) (T, bool) {
+	if value, ok := v.(T); ok {
+		return value, true
+	}
+	var zero T
+	if v != nil {
+		return zero, false
+	}
+	switch reflect.TypeOf(&zero).Elem().Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+		return zero, true
+	}
+	return zero, false
+}
+
+// The valueOf() function is the same as assert() function, but returns the
+// zero value of T if v cannot be converted.
+func valueOf[T any](v interface{}) T {
+	value, _ := assert[T](v)
+	return value
+}
@@ -0,0 +1,30 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+/*
+The Walk visits every node of a nested value, such as an Array or an Object
+that holds Collections, native slices and native maps. The elements of a
+Collection are visited in insertion order, the elements of a slice or an
+array in index order, and the elements of a map, including an Object, in
+the order of their keys. The fields of a struct and the values behind a
+pointer are not visited.
+
+The visitor receives each node with its path from the root, its key or
+index, its value and its parent. It can replace the node, remove it from
+its parent, skip its children or stop the walk. The nodes are visited
+before their children in PreOrder, and after their children in PostOrder.
+
+The parents are changed in place, except that a slice is copied when one of
+its elements is removed, and an array is copied when one of its elements is
+replaced. The copy then replaces the original in its own parent, so the
+Walk() function returns the root, which is the copy if the root itself has
+been copied or replaced. If a node is replaced by a value that its native
+parent cannot hold, such as a string in a []int, the walk is stopped and
+the Walk() function returns InvalidValueError instead.
+
+A value that holds one of its ancestors is visited, but its children are
+not, so a circular value is walked only once.
+*/
+package walk
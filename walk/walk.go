@@ -0,0 +1,332 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package walk
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/with-go/standard/collection"
+	"github.com/with-go/standard/cycle"
+)
+
+var (
+	InvalidValueError = errors.New("the given value cannot be held by the parent")
+)
+
+// Order defines when a node is visited, relative to its children.
+type Order int
+
+const (
+	// PreOrder visits a node before its children.
+	PreOrder Order = iota
+	// PostOrder visits a node after its children.
+	PostOrder
+)
+
+// Action defines what the walk does after a node has been visited.
+type Action int
+
+const (
+	// Continue visits the children of the node, and then the next node.
+	Continue Action = iota
+	// Skip does not visit the children of the node. It has no effect in
+	// PostOrder, where the children have already been visited.
+	Skip
+	// Stop ends the walk. The changes made so far are kept.
+	Stop
+	// Remove removes the node from its parent, without visiting its
+	// children. Removing the root makes the Walk() function return nil.
+	Remove
+)
+
+// Options defines the options used to walk a value. Use DefaultOptions as
+// the starting point of custom options.
+type Options struct {
+	// Order defines whether a node is visited before or after its children.
+	Order Order
+}
+
+// DefaultOptions visits the nodes in PreOrder.
+var DefaultOptions = Options{
+	Order: PreOrder,
+}
+
+// Node describes a node being visited.
+type Node struct {
+	// Path is the list of keys and indexes from the root to the node. It is
+	// empty for the root. The index of an element is its index before any
+	// element of the same parent has been removed.
+	Path []interface{}
+	// Key is the key or the index of the node in its parent. It is nil for
+	// the root.
+	Key interface{}
+	// Value is the value of the node.
+	Value interface{}
+	// Parent is the Collection, slice, array or map holding the node. It is
+	// nil for the root.
+	Parent     interface{}
+	isReplaced bool
+}
+
+// The PathString() function returns the Path written as a JSONPath, such as
+// $.a[0], the same way as FormatPath() function of "cycle" package does.
+func (node *Node) PathString() string {
+	return cycle.FormatPath(node.Path)
+}
+
+// The Replace() function replaces the value of the node in its parent. In
+// PreOrder, the children of the new value are visited instead of the
+// children of the old value. If the parent is a native slice, array or map
+// whose elements cannot hold the new value, the walk is stopped and the
+// Walk() function returns InvalidValueError.
+func (node *Node) Replace(value interface{}) {
+	node.Value = value
+	node.isReplaced = true
+}
+
+// The Walk() function visits every node of the given root in PreOrder, and
+// returns the root. See "walk" package documentation for more information.
+func Walk(root interface{}, visitor VisitFunc) (interface{}, error) {
+	return WalkWithOptions(root, DefaultOptions, visitor)
+}
+
+// The WalkWithOptions() function visits every node of the given root using
+// the given Options, and returns the root. If a node has been replaced by a
+// value that its parent cannot hold, the walk is stopped, and it will
+// returns InvalidValueError. The changes made before are kept.
+func WalkWithOptions(root interface{}, options Options, visitor VisitFunc) (interface{}, error) {
+	walker := &walker{options: options, visitor: visitor}
+	result, _, action := walker.walk(nil, nil, root)
+	if walker.err != nil {
+		return nil, walker.err
+	}
+	if action == Remove {
+		return nil, nil
+	}
+	return result, nil
+}
+
+// walker holds the state of a single walk.
+type walker struct {
+	options Options
+	visitor VisitFunc
+	tracker cycle.Tracker
+	// err is the error that stopped the walk.
+	err error
+}
+
+// The walk() function visits the given node and its children. It returns
+// the value of the node, whether the value has changed, and the Action
+// that its parent has to take.
+func (walker *walker) walk(key interface{}, parent interface{}, value interface{}) (interface{}, bool, Action) {
+	isChanged := false
+	if walker.options.Order == PreOrder {
+		var action Action
+		value, isChanged, action = walker.visit(key, parent, value)
+		switch action {
+		case Skip:
+			return value, isChanged, Continue
+		case Stop, Remove:
+			return value, isChanged, action
+		}
+	}
+	value, isChildChanged, isStopped := walker.children(value)
+	isChanged = isChanged || isChildChanged
+	if isStopped {
+		return value, isChanged, Stop
+	}
+	if walker.options.Order == PostOrder {
+		result, isReplaced, action := walker.visit(key, parent, value)
+		if action == Skip {
+			action = Continue
+		}
+		return result, isChanged || isReplaced, action
+	}
+	return value, isChanged, Continue
+}
+
+// The visit() function calls the visitor with the given node. It returns
+// the value of the node, whether the visitor has replaced it, and the
+// Action returned by the visitor.
+func (walker *walker) visit(key interface{}, parent interface{}, value interface{}) (interface{}, bool, Action) {
+	node := &Node{
+		Path:   walker.tracker.Path(),
+		Key:    key,
+		Value:  value,
+		Parent: parent,
+	}
+	action := walker.visitor(node)
+	if node.isReplaced {
+		return node.Value, true, action
+	}
+	return value, false, action
+}
+
+// The child() function walks an element of the given parent.
+func (walker *walker) child(key interface{}, parent interface{}, value interface{}) (interface{}, bool, Action) {
+	walker.tracker.Push(key)
+	defer walker.tracker.Pop()
+	return walker.walk(key, parent, value)
+}
+
+// The children() function walks the elements of the given value. It
+// returns the value, or its copy, whether it has been copied, and whether
+// the walk has been stopped.
+func (walker *walker) children(value interface{}) (interface{}, bool, bool) {
+	if !walker.tracker.Enter(value) {
+		return value, false, false
+	}
+	defer walker.tracker.Leave(value)
+	if parent, ok := value.(*collection.Collection); ok {
+		if parent == nil {
+			return value, false, false
+		}
+		for _, key := range parent.Keys() {
+			result, isChanged, action := walker.child(key, parent, parent.Get(key))
+			if action == Remove {
+				parent.Delete(key)
+			} else if isChanged {
+				parent.Set(key, result)
+			}
+			if action == Stop {
+				return value, false, true
+			}
+		}
+		return value, false, false
+	}
+	reflection := reflect.ValueOf(value)
+	switch reflection.Kind() {
+	case reflect.Slice:
+		return walker.slice(reflection)
+	case reflect.Array:
+		return walker.array(reflection)
+	case reflect.Map:
+		return walker.mapping(reflection)
+	}
+	return value, false, false
+}
+
+// The slice() function walks the elements of a native slice. The elements
+// are replaced in place, and the slice is copied once an element has been
+// removed.
+func (walker *walker) slice(parent reflect.Value) (interface{}, bool, bool) {
+	value := parent.Interface()
+	kept := reflect.MakeSlice(parent.Type(), 0, parent.Len())
+	isRemoved, isStopped := false, false
+	for index := 0; index < parent.Len() && !isStopped; index++ {
+		result, isChanged, action := walker.child(index, value, parent.Index(index).Interface())
+		if action == Remove {
+			isRemoved = true
+			continue
+		}
+		if isChanged {
+			element, err := valueOf(result, parent.Type().Elem())
+			if err != nil {
+				walker.err = err
+				return value, false, true
+			}
+			parent.Index(index).Set(element)
+		}
+		kept = reflect.Append(kept, parent.Index(index))
+		if action == Stop {
+			kept = reflect.AppendSlice(kept, parent.Slice(index+1, parent.Len()))
+			isStopped = true
+		}
+	}
+	if isRemoved {
+		return kept.Interface(), true, isStopped
+	}
+	return value, false, isStopped
+}
+
+// The array() function walks the elements of a native array. The array is
+// copied once an element has been replaced, and a removed element is set
+// to the zero value, since an array cannot change its length.
+func (walker *walker) array(parent reflect.Value) (interface{}, bool, bool) {
+	value := parent.Interface()
+	cloned := reflect.New(parent.Type()).Elem()
+	cloned.Set(parent)
+	isCopied := false
+	for index := 0; index < parent.Len(); index++ {
+		result, isChanged, action := walker.child(index, value, parent.Index(index).Interface())
+		if action == Remove {
+			cloned.Index(index).Set(reflect.Zero(parent.Type().Elem()))
+			isCopied = true
+		} else if isChanged {
+			element, err := valueOf(result, parent.Type().Elem())
+			if err != nil {
+				walker.err = err
+				return value, false, true
+			}
+			cloned.Index(index).Set(element)
+			isCopied = true
+		}
+		if action == Stop {
+			return copyOf(value, cloned, isCopied), isCopied, true
+		}
+	}
+	return copyOf(value, cloned, isCopied), isCopied, false
+}
+
+// The mapping() function walks the elements of a native map, in the order
+// of their keys. The elements are replaced and removed in place.
+func (walker *walker) mapping(parent reflect.Value) (interface{}, bool, bool) {
+	value := parent.Interface()
+	keys := parent.MapKeys()
+	sort.Slice(keys, func(i int, j int) bool {
+		return fmt.Sprintf("%v", keys[i].Interface()) < fmt.Sprintf("%v", keys[j].Interface())
+	})
+	for _, key := range keys {
+		result, isChanged, action := walker.child(key.Interface(), value, parent.MapIndex(key).Interface())
+		if action == Remove {
+			parent.SetMapIndex(key, reflect.Value{})
+		} else if isChanged {
+			element, err := valueOf(result, parent.Type().Elem())
+			if err != nil {
+				walker.err = err
+				return value, false, true
+			}
+			parent.SetMapIndex(key, element)
+		}
+		if action == Stop {
+			return value, false, true
+		}
+	}
+	return value, false, false
+}
+
+// The copyOf() function returns the copy of an array if it has been
+// changed, or the original array otherwise.
+func copyOf(value interface{}, cloned reflect.Value, isCopied bool) interface{} {
+	if isCopied {
+		return cloned.Interface()
+	}
+	return value
+}
+
+// The valueOf() function returns the given value as an element of the
+// given type. If the type cannot hold it, it will returns InvalidValueError.
+func valueOf(value interface{}, elementType reflect.Type) (reflect.Value, error) {
+	if value == nil {
+		switch elementType.Kind() {
+		case reflect.Interface, reflect.Ptr, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+			return reflect.Zero(elementType), nil
+		}
+		return reflect.Value{}, InvalidValueError
+	}
+	reflection := reflect.ValueOf(value)
+	if !reflection.Type().AssignableTo(elementType) {
+		return reflect.Value{}, InvalidValueError
+	}
+	return reflection, nil
+}
+
+// VisitFunc defines a function that visits a node, and returns the Action
+// that the walk takes next.
+type VisitFunc func (node *Node) Action
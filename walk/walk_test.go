@@ -0,0 +1,217 @@
+// Copyright © 2020 The With-Go Authors. All rights reserved.
+// Licensed under the BSD 3-Clause License.
+// You may not use this file except in compliance with the license
+// that can be found in the LICENSE.md file.
+
+package walk
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/with-go/standard/array"
+	"github.com/with-go/standard/collection"
+	"github.com/with-go/standard/object"
+)
+
+func tree() *collection.Collection {
+	return collection.New().
+		Set("z", array.New(1, object.New().Set("b", 2).Set("a", 3))).
+		Set("y", map[string][]int{"c": {4, 5}}).
+		Set("x", [2]string{"d", "e"})
+}
+
+func paths(root interface{}, options Options) []string {
+	visited := []string{}
+	WalkWithOptions(root, options, func(node *Node) Action {
+		visited = append(visited, node.PathString())
+		return Continue
+	})
+	return visited
+}
+
+func TestWalk(t *testing.T) {
+	expected := []string{"$", "$.z", "$.z[0]", "$.z[1]", "$.z[1].a", "$.z[1].b", "$.y", "$.y.c", "$.y.c[0]", "$.y.c[1]", "$.x", "$.x[0]", "$.x[1]"}
+	if visited := paths(tree(), DefaultOptions); !reflect.DeepEqual(visited, expected) {
+		t.Error("walk.Walk() order does not match")
+		t.Errorf("Expecting %v, got %v", expected, visited)
+		return
+	}
+	root := tree()
+	Walk(root, func(node *Node) Action {
+		if node.Key == "a" {
+			if node.Parent.(object.Object)["b"] != 2 || !reflect.DeepEqual(node.Path, []interface{}{"z", 1, "a"}) {
+				t.Error("walk.Walk() node does not match")
+				t.Errorf("Got %v", node)
+			}
+		}
+		return Continue
+	})
+}
+
+func TestWalkWithOptions_postOrder(t *testing.T) {
+	expected := []string{"$.z[0]", "$.z[1].a", "$.z[1].b", "$.z[1]", "$.z", "$.y.c[0]", "$.y.c[1]", "$.y.c", "$.y", "$.x[0]", "$.x[1]", "$.x", "$"}
+	if visited := paths(tree(), Options{Order: PostOrder}); !reflect.DeepEqual(visited, expected) {
+		t.Error("walk.WalkWithOptions() order does not match")
+		t.Errorf("Expecting %v, got %v", expected, visited)
+		return
+	}
+	result, _ := WalkWithOptions(array.New(array.New(1, 2), 3, array.New(array.New(4))), Options{Order: PostOrder}, func(node *Node) Action {
+		if value, ok := node.Value.(array.Array); ok {
+			sum := 0
+			for _, element := range value {
+				sum += element.(int)
+			}
+			node.Replace(sum)
+		}
+		return Continue
+	})
+	if result != 10 {
+		t.Error("walk.WalkWithOptions() replaced value does not match")
+		t.Errorf("Expecting %v, got %v", 10, result)
+		return
+	}
+}
+
+func TestWalk_replace(t *testing.T) {
+	root := tree()
+	result, _ := Walk(root, func(node *Node) Action {
+		switch value := node.Value.(type) {
+		case int:
+			node.Replace(value * 10)
+		case string:
+			node.Replace(strings.ToUpper(value))
+		}
+		return Continue
+	})
+	expected := "{\"z\":[10,{\"a\":30,\"b\":20}],\"y\":{\"c\":[40,50]},\"x\":[\"D\",\"E\"]}"
+	if result != root || root.String() != expected {
+		t.Error("walk.Walk() replaced value does not match")
+		t.Errorf("Expecting %v, got %v", expected, root.String())
+		return
+	}
+	replaced, _ := Walk(array.New(1, 2), func(node *Node) Action {
+		if node.Parent == nil {
+			node.Replace(array.New(3, array.New(4)))
+		} else if value, ok := node.Value.(int); ok {
+			node.Replace(value + 1)
+		}
+		return Continue
+	})
+	if replaced.(array.Array).String() != "[4,[5]]" {
+		t.Error("walk.Walk() replaced root does not match")
+		t.Errorf("Expecting %v, got %v", "[4,[5]]", replaced)
+		return
+	}
+}
+
+func TestWalk_replaceInvalid(t *testing.T) {
+	visited := 0
+	result, err := Walk([]int{1, 2}, func(node *Node) Action {
+		if node.Parent != nil {
+			visited++
+			node.Replace("a")
+		}
+		return Continue
+	})
+	if result != nil || err != InvalidValueError || visited != 1 {
+		t.Error("walk.Walk() error does not match")
+		t.Errorf("Expecting %v, got %v", InvalidValueError, err)
+		return
+	}
+	for _, root := range []interface{}{[1]int{1}, map[string]int{"a": 1}} {
+		if _, err := Walk(root, func(node *Node) Action {
+			if node.Parent != nil {
+				node.Replace(nil)
+			}
+			return Continue
+		}); err != InvalidValueError {
+			t.Error("walk.Walk() error does not match")
+			t.Errorf("Expecting %v, got %v", InvalidValueError, err)
+			return
+		}
+	}
+}
+
+func TestWalk_remove(t *testing.T) {
+	root := tree()
+	Walk(root, func(node *Node) Action {
+		if value, ok := node.Value.(int); ok && value%2 == 1 || node.Key == "y" || node.Value == "d" {
+			return Remove
+		}
+		return Continue
+	})
+	expected := "{\"z\":[{\"b\":2}],\"x\":[\"\",\"e\"]}"
+	if root.String() != expected {
+		t.Error("walk.Walk() value after removal does not match")
+		t.Errorf("Expecting %v, got %v", expected, root.String())
+		return
+	}
+	removed, _ := Walk(array.New(1), func(node *Node) Action {
+		return Remove
+	})
+	if removed != nil {
+		t.Error("walk.Walk() removed root does not match")
+		t.Errorf("Expecting %v, got %v", nil, removed)
+		return
+	}
+}
+
+func TestWalk_skip(t *testing.T) {
+	visited := []string{}
+	Walk(tree(), func(node *Node) Action {
+		visited = append(visited, node.PathString())
+		if node.Key == "z" || node.Key == "c" {
+			return Skip
+		}
+		return Continue
+	})
+	expected := []string{"$", "$.z", "$.y", "$.y.c", "$.x", "$.x[0]", "$.x[1]"}
+	if !reflect.DeepEqual(visited, expected) {
+		t.Error("walk.Walk() skipped value does not match")
+		t.Errorf("Expecting %v, got %v", expected, visited)
+		return
+	}
+}
+
+func TestWalk_stop(t *testing.T) {
+	root := array.New(1, 2, 3, array.New(4))
+	visited := []interface{}{}
+	result, _ := Walk(root, func(node *Node) Action {
+		visited = append(visited, node.Value)
+		if node.Value == 1 {
+			return Remove
+		}
+		if node.Value == 2 {
+			return Stop
+		}
+		return Continue
+	})
+	if len(visited) != 3 {
+		t.Error("walk.Walk() visited values do not match")
+		t.Errorf("Expecting %v, got %v", 3, len(visited))
+		return
+	}
+	if result.(array.Array).String() != "[2,3,[4]]" || root.String() != "[1,2,3,[4]]" {
+		t.Error("walk.Walk() value after stop does not match")
+		t.Errorf("Expecting %v, got %v", "[2,3,[4]]", result)
+		return
+	}
+}
+
+func TestWalk_circular(t *testing.T) {
+	root := object.New().Set("a", 1)
+	root["self"] = root
+	visited := []string{}
+	Walk(root, func(node *Node) Action {
+		visited = append(visited, node.PathString())
+		return Continue
+	})
+	expected := []string{"$", "$.a", "$.self"}
+	if !reflect.DeepEqual(visited, expected) {
+		t.Error("walk.Walk() circular value does not match")
+		t.Errorf("Expecting %v, got %v", expected, visited)
+		return
+	}
+}